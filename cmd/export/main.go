@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"market-engine-go/internal/infrastructure/export"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/utils"
+)
+
+func main() {
+	storage := flag.String("storage", repository.StorageCSV, "where daily snapshots are read from: csv or sqlite")
+	dataDir := flag.String("data-dir", "./output", "directory of daily stock snapshots")
+	sqlitePath := flag.String("sqlite-path", "./output/market.db", "SQLite database for -storage sqlite")
+	numberFormat := flag.String("number-format", string(utils.NumberFormatIDX), "number format of snapshot files: idx (7.475 is 7475) or plain")
+	outDir := flag.String("out", "./output/export/snapshots", "directory to write converted snapshots to")
+	format := flag.String("format", export.FormatParquet, "output format: parquet or jsonl")
+	overwrite := flag.Bool("overwrite", false, "convert days that were already exported again")
+	flag.Parse()
+
+	parsedFormat, err := utils.ParseNumberFormat(*numberFormat)
+	if err != nil {
+		log.Fatalf("Invalid number format: %v", err)
+	}
+
+	repo, err := repository.Open(*storage, *dataDir, *sqlitePath, parsedFormat)
+	if err != nil {
+		log.Fatalf("Failed to open stock repository: %v", err)
+	}
+
+	written, err := export.ExportSnapshots(repo, *outDir, *format, *overwrite)
+	if err != nil {
+		log.Fatalf("Export failed after %d files: %v", written, err)
+	}
+
+	log.Printf("Exported %d daily snapshots to %s", written, *outDir)
+}
@@ -1,22 +1,238 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	marketv1 "market-engine-go/gen/go/market/v1"
+	"market-engine-go/internal/infrastructure/accounts"
+	"market-engine-go/internal/infrastructure/agents"
+	"market-engine-go/internal/infrastructure/auth"
+	"market-engine-go/internal/infrastructure/calendar"
+	corporateactions "market-engine-go/internal/infrastructure/corporate-actions"
+	"market-engine-go/internal/infrastructure/executions"
+	"market-engine-go/internal/infrastructure/export"
 	grpcserver "market-engine-go/internal/infrastructure/grpc"
+	"market-engine-go/internal/infrastructure/journal"
 	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	marketmaker "market-engine-go/internal/infrastructure/market-maker"
+	"market-engine-go/internal/infrastructure/portfolio"
+	"market-engine-go/internal/infrastructure/replay"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/infrastructure/risk"
+	"market-engine-go/internal/infrastructure/scenario"
+	"market-engine-go/internal/utils"
 )
 
 func main() {
-	engine := marketengine.New()
+	marketMakerConfig := flag.String("market-maker-config", "", "path to a JSON market maker settings file")
+	agentsConfig := flag.String("agents-config", "", "path to a JSON agent simulation settings file")
+	feeConfig := flag.String("fee-config", "", "path to a JSON file of account fee tiers")
+	riskConfig := flag.String("risk-config", "", "path to a JSON file of pre-trade limits for account orders")
+	authConfig := flag.String("auth-config", "", "path to a JSON file of API keys and JWT settings gRPC callers authenticate with; empty leaves calls unauthenticated")
+	policyConfig := flag.String("policy-config", "", "path to a JSON file of the roles allowed to call each gRPC method; needs -auth-config")
+	marginConfig := flag.String("margin-config", "", "path to a JSON file of margin ratios and marginable symbols")
+	holidays := flag.String("holidays", "./config/idx_holidays.yaml", "exchange holiday calendar fills settle on; empty treats every weekday as a trading day")
+	settlementDays := flag.Int("settlement-days", 2, "trading days from trade date to settlement")
+	spendUnsettled := flag.Bool("spend-unsettled", true, "let unsettled sale proceeds pay for buys")
+	portfolioInterval := flag.Duration("portfolio-interval", time.Second, "least time between StreamPortfolio updates when a client sets none")
+	portfolioMinInterval := flag.Duration("portfolio-min-interval", 250*time.Millisecond, "least time between StreamPortfolio updates a client can ask for")
+	scenarioFile := flag.String("scenario", "", "path to a YAML or JSON scenario to run at startup")
+	journalDir := flag.String("journal-dir", "./output/journal", "directory for the event journal and snapshots; empty disables journaling")
+	journalFsync := flag.String("journal-fsync", journal.FsyncInterval, "journal fsync policy: always, interval or never")
+	snapshotInterval := flag.Duration("snapshot-interval", time.Minute, "how often to snapshot engine state")
+	historyPath := flag.String("history-path", "./output/history.db", "SQLite database of accounts' execution reports")
+	dataDir := flag.String("data-dir", "./output", "directory of daily stock snapshots")
+	storage := flag.String("storage", repository.StorageCSV, "where daily snapshots are read from: csv or sqlite")
+	sqlitePath := flag.String("sqlite-path", "./output/market.db", "SQLite database for -storage sqlite; engine trades and candles are recorded there too")
+	numberFormat := flag.String("number-format", string(utils.NumberFormatIDX), "number format of snapshot files: idx (7.475 is 7475) or plain")
+	snapshotDate := flag.String("snapshot-date", "", "load reference prices from this day's snapshot (YYYY-MM-DD) instead of the latest")
+	exportDir := flag.String("export-dir", "", "directory to export trades, ticks and daily snapshots to; empty disables exporting")
+	exportFormat := flag.String("export-format", export.FormatParquet, "export format: parquet or jsonl")
+	exportRotateInterval := flag.Duration("export-rotate-interval", time.Hour, "start a new trade and tick file after this long; 0 disables")
+	exportRotateBytes := flag.Int64("export-rotate-bytes", 64<<20, "start a new trade and tick file past this size; 0 disables")
+	replayPath := flag.String("replay", "", "journal directory or CSV tape to replay instead of simulating")
+	replaySpeed := flag.Float64("replay-speed", 1, "replay speed multiple; 0 starts paused for stepping")
+	watchDataDir := flag.Bool("watch-data-dir", false, "reload reference data when a newer snapshot is written to -data-dir (csv storage only)")
+	flag.Parse()
 
-	log.Println("Market Engine Simulation Starting...")
-	engine.StartSimulation()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	format, err := utils.ParseNumberFormat(*numberFormat)
+	if err != nil {
+		log.Fatalf("Invalid number format: %v", err)
+	}
+
+	snapshots, err := repository.Open(*storage, *dataDir, *sqlitePath, format)
+	if err != nil {
+		log.Fatalf("Failed to open stock repository: %v", err)
+	}
+
+	var referenceDate time.Time
+	if *snapshotDate != "" {
+		date, err := repository.ParseSnapshotDate(*snapshotDate)
+		if err != nil {
+			log.Fatalf("Invalid snapshot date: %v", err)
+		}
+		referenceDate = date
+	}
+
+	engine := marketengine.New(snapshots, referenceDate)
+	if !referenceDate.IsZero() && engine.ReferenceDate().IsZero() {
+		log.Fatalf("No snapshot for %s", *snapshotDate)
+	}
+
+	feeSettings := accounts.DefaultFeeSettings()
+	if *feeConfig != "" {
+		feeSettings, err = accounts.LoadFeeSettings(*feeConfig)
+		if err != nil {
+			log.Fatalf("Failed to load fee config: %v", err)
+		}
+	}
+
+	ledger := accounts.NewLedger(engine, feeSettings)
+	ledger.Settlement.Days = *settlementDays
+	ledger.Settlement.SpendUnsettled = *spendUnsettled
+	if *marginConfig != "" {
+		ledger.Margin, err = accounts.LoadMarginSettings(*marginConfig)
+		if err != nil {
+			log.Fatalf("Failed to load margin config: %v", err)
+		}
+	}
+	if *holidays != "" {
+		ledger.Calendar, err = calendar.Load(*holidays)
+		if err != nil {
+			log.Fatalf("Failed to load holiday calendar: %v", err)
+		}
+	}
+	engine.SetLedger(ledger)
+
+	riskSettings := risk.DefaultSettings()
+	if *riskConfig != "" {
+		riskSettings, err = risk.LoadSettings(*riskConfig)
+		if err != nil {
+			log.Fatalf("Failed to load risk config: %v", err)
+		}
+	}
+	engine.SetPreTradeCheck(risk.NewChecker(ledger, riskSettings).Check)
+
+	var player *replay.Player
+	if *replayPath != "" {
+		tape, err := replay.Load(*replayPath)
+		if err != nil {
+			log.Fatalf("Failed to load replay tape: %v", err)
+		}
+
+		player = replay.NewPlayer(engine, tape, *replaySpeed)
+		go player.Run(ctx)
+	}
+
+	// A replay only re-emits recorded market data, so it is not journalled
+	// and runs without simulated participants.
+	var eventJournal *journal.Journal
+	if player == nil && *journalDir != "" {
+		recovery, err := journal.Recover(*journalDir)
+		if err != nil {
+			log.Fatalf("Failed to read journal: %v", err)
+		}
+
+		engine.Restore(recovery.Snapshot, recovery.Events)
+		log.Printf("Recovered engine state from %s (%d events after snapshot)", *journalDir, len(recovery.Events))
+
+		options := journal.DefaultOptions()
+		options.Fsync = *journalFsync
+
+		eventJournal, err = journal.Open(*journalDir, options)
+		if err != nil {
+			log.Fatalf("Failed to open journal: %v", err)
+		}
+
+		engine.AddEventListener(eventJournal.Append)
+		go eventJournal.RunSnapshots(ctx, engine, *snapshotInterval)
+	}
+
+	var tradeRecorder *repository.TradeRecorder
+	if database, ok := snapshots.(*repository.SqliteStockRepository); ok {
+		defer database.Close()
+
+		if player == nil {
+			tradeRecorder = database.NewTradeRecorder()
+			engine.AddTradeListener(tradeRecorder.Record)
+			go tradeRecorder.Run(ctx)
+		}
+	}
+
+	history, err := repository.OpenHistoryStore(*historyPath)
+	if err != nil {
+		log.Fatalf("Failed to open history store: %v", err)
+	}
+	defer history.Close()
+
+	reports := executions.NewService(engine, ledger, history)
+	if err := reports.Start(); err != nil {
+		log.Fatalf("Failed to start execution reports: %v", err)
+	}
+	go reports.Run(ctx)
+
+	var exporter *export.Exporter
+	if *exportDir != "" {
+		written, err := export.ExportSnapshots(snapshots, filepath.Join(*exportDir, "snapshots"), *exportFormat, false)
+		if err != nil {
+			log.Fatalf("Failed to export daily snapshots: %v", err)
+		}
+		log.Printf("Exported %d daily snapshots to %s", written, *exportDir)
+
+		exporter, err = export.NewExporter(export.Options{
+			Dir:      *exportDir,
+			Format:   *exportFormat,
+			MaxAge:   *exportRotateInterval,
+			MaxBytes: *exportRotateBytes,
+		})
+		if err != nil {
+			log.Fatalf("Failed to start exporter: %v", err)
+		}
+
+		engine.AddEventListener(exporter.Record)
+		go exporter.Run(ctx)
+	}
+
+	if player == nil {
+		log.Println("Market Engine Simulation Starting...")
+
+		startSimulation(ctx, engine, *marketMakerConfig, *agentsConfig, *scenarioFile)
+	} else {
+		log.Printf("Market Engine Replay Starting from %s", *replayPath)
+	}
+
+	go ledger.RunMarginCalls(ctx)
+
+	// Reference data reloads, corporate actions and settlement follow the
+	// simulated days, which a replay does not have.
+	if player == nil {
+		go corporateactions.NewApplier(engine, snapshots).Run(ctx)
+		go ledger.RunSettlement(ctx)
+		go reloadOnSignal(ctx, engine, snapshots)
+
+		if *watchDataDir {
+			store, ok := snapshots.(*repository.SnapshotStore)
+			if !ok {
+				log.Fatalf("-watch-data-dir needs -storage %s", repository.StorageCSV)
+			}
+
+			go watchSnapshots(ctx, engine, store)
+		}
+	}
 
 	log.Println("Press Ctrl+C to stop")
 
@@ -26,14 +242,197 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	server := grpc.NewServer()
+	var serverOptions []grpc.ServerOption
+	var policy *auth.Policy
+	if *policyConfig != "" && *authConfig == "" {
+		log.Fatalf("-policy-config needs -auth-config: roles come from the caller's credentials")
+	}
+	if *authConfig != "" {
+		authSettings, err := auth.LoadSettings(*authConfig)
+		if err != nil {
+			log.Fatalf("Failed to load auth config: %v", err)
+		}
+
+		authenticator, err := auth.New(authSettings)
+		if err != nil {
+			log.Fatalf("Failed to set up authentication: %v", err)
+		}
+
+		interceptor := auth.NewInterceptor(authenticator, authSettings.PublicMethods)
+		serverOptions = append(serverOptions,
+			grpc.ChainUnaryInterceptor(interceptor.Unary),
+			grpc.ChainStreamInterceptor(interceptor.Stream),
+		)
+		log.Printf("gRPC calls require credentials: %d API keys, %d public methods", len(authSettings.APIKeys), len(authSettings.PublicMethods))
+		if authSettings.JWT != nil {
+			log.Printf("gRPC calls can also carry %s bearer tokens", authSettings.JWT.Algorithm)
+		}
+
+		if *policyConfig != "" {
+			loaded, err := auth.LoadPolicy(*policyConfig)
+			if err != nil {
+				log.Fatalf("Failed to load policy config: %v", err)
+			}
+			policy = &loaded
 
-	marketv1.RegisterMarketServiceServer(server, &grpcserver.MarketServer{Engine: engine})
+			authorizer := auth.NewAuthorizer(loaded, ledger)
+			serverOptions = append(serverOptions,
+				grpc.ChainUnaryInterceptor(authorizer.Unary),
+				grpc.ChainStreamInterceptor(authorizer.Stream),
+			)
+		} else {
+			log.Println("Every authenticated caller may call every method; set -policy-config to limit them by role")
+		}
+	} else {
+		log.Println("gRPC calls are not authenticated; set -auth-config to require credentials")
+	}
+
+	server := grpc.NewServer(serverOptions...)
+
+	marketv1.RegisterMarketServiceServer(server, &grpcserver.MarketServer{
+		Engine:     engine,
+		Snapshots:  snapshots,
+		Accounts:   ledger,
+		Executions: reports,
+	})
+	marketv1.RegisterAdminServiceServer(server, &grpcserver.AdminServer{Engine: engine, Replay: player, Snapshots: snapshots})
+	portfolios := portfolio.NewService(engine, ledger, snapshots)
+	portfolios.Start(ctx)
+
+	marketv1.RegisterAccountServiceServer(server, &grpcserver.AccountServer{
+		Engine:     engine,
+		Ledger:     ledger,
+		Portfolios: portfolios,
+		Executions: reports,
+	})
+	marketv1.RegisterPortfolioServiceServer(server, &grpcserver.PortfolioServer{
+		Ledger:          ledger,
+		Portfolios:      portfolios,
+		DefaultInterval: *portfolioInterval,
+		MinInterval:     *portfolioMinInterval,
+	})
 	reflection.Register(server)
 
+	if policy != nil {
+		unruled, err := policy.Check(server.GetServiceInfo())
+		if err != nil {
+			log.Fatalf("Invalid policy config: %v", err)
+		}
+		for _, method := range unruled {
+			log.Printf("[Auth] No policy rule for %s; it is denied to every authenticated caller", method)
+		}
+		log.Printf("gRPC calls are authorized by role: %d rules", len(policy.Methods))
+	}
+
 	log.Printf("gRPC Server listening on %s", port)
 
+	go func() {
+		<-ctx.Done()
+		log.Println("Shutting down")
+		server.Stop()
+	}()
+
 	if err := server.Serve(listener); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+
+	if eventJournal != nil {
+		if err := eventJournal.WriteSnapshot(engine.Snapshot()); err != nil {
+			log.Printf("Failed to write final snapshot: %v", err)
+		}
+		if err := eventJournal.Close(); err != nil {
+			log.Printf("Failed to close journal: %v", err)
+		}
+	}
+
+	if tradeRecorder != nil {
+		<-tradeRecorder.Done()
+	}
+	if exporter != nil {
+		<-exporter.Done()
+	}
+	<-reports.Done()
+}
+
+func startSimulation(ctx context.Context, engine *marketengine.MarketEngine, marketMakerConfig string, agentsConfig string, scenarioFile string) {
+	marketMakerSettings := marketmaker.DefaultSettings()
+	if marketMakerConfig != "" {
+		settings, err := marketmaker.LoadSettings(marketMakerConfig)
+		if err != nil {
+			log.Fatalf("Failed to load market maker settings: %v", err)
+		}
+		marketMakerSettings = settings
+	}
+
+	marketmaker.NewManager(engine, marketMakerSettings).Start(ctx)
+
+	agentSettings := agents.DefaultSettings()
+	if agentsConfig != "" {
+		settings, err := agents.LoadSettings(agentsConfig)
+		if err != nil {
+			log.Fatalf("Failed to load agent settings: %v", err)
+		}
+		agentSettings = settings
+	}
+
+	agents.NewSimulation(engine, agentSettings).Start(ctx)
+
+	if scenarioFile != "" {
+		definition, err := scenario.Load(scenarioFile)
+		if err != nil {
+			log.Fatalf("Failed to load scenario: %v", err)
+		}
+
+		runner := scenario.NewRunner(engine, definition)
+		if err := runner.Check(); err != nil {
+			log.Fatalf("Invalid scenario: %v", err)
+		}
+
+		go func() {
+			if err := runner.Run(ctx); err != nil {
+				log.Printf("Scenario failed: %v", err)
+			}
+		}()
+	}
+}
+
+// reloadOnSignal reloads reference data from the latest snapshot on SIGHUP.
+func reloadOnSignal(ctx context.Context, engine *marketengine.MarketEngine, snapshots repository.StockRepository) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			reloadReferenceData(engine, snapshots, time.Time{})
+		}
+	}
+}
+
+// watchSnapshots reloads reference data when a snapshot at least as recent
+// as the loaded one is written.
+func watchSnapshots(ctx context.Context, engine *marketengine.MarketEngine, store *repository.SnapshotStore) {
+	err := store.Watch(ctx, func(date time.Time) {
+		if date.Before(engine.ReferenceDate()) {
+			return
+		}
+
+		reloadReferenceData(engine, store, date)
+	})
+	if err != nil {
+		log.Printf("Failed to watch for snapshots: %v", err)
+	}
+}
+
+func reloadReferenceData(engine *marketengine.MarketEngine, snapshots repository.StockRepository, date time.Time) {
+	result, err := engine.ReloadReferenceData(snapshots, date)
+	if err != nil {
+		log.Printf("Failed to reload reference data: %v", err)
+		return
+	}
+
+	log.Printf("Reloaded %d symbols from the %s snapshot (%d listed, %d delisted)", result.Symbols, result.Date.Format("2006-01-02"), len(result.Listed), len(result.Delisted))
 }
@@ -1,7 +1,177 @@
 package main
 
-import "market-engine-go/internal/infrastructure/scraper"
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"market-engine-go/internal/infrastructure/calendar"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/infrastructure/scraper"
+	"market-engine-go/internal/utils"
+)
 
 func main() {
-	scraper.GetIdxTodaySnapshot()
+	storage := flag.String("storage", repository.StorageCSV, "where the snapshot is saved: csv or sqlite")
+	dataDir := flag.String("data-dir", "./output", "directory of daily stock snapshots")
+	sqlitePath := flag.String("sqlite-path", "./output/market.db", "SQLite database for -storage sqlite")
+	fetcherKind := flag.String("fetcher", "http", "how pages are fetched: http, selenium or fixture")
+	chromePath := flag.String("chrome-path", "", "Chrome or chrome-headless-shell binary for -fetcher selenium; empty uses the installed Chrome")
+	chromeDriverPath := flag.String("chromedriver-path", "", "chromedriver binary for -fetcher selenium; empty looks it up on PATH")
+	seleniumPort := flag.Int("selenium-port", 4444, "chromedriver port for -fetcher selenium")
+	fixtureDir := flag.String("fixture-dir", "./internal/infrastructure/scraper/testdata", "saved pages for -fetcher fixture")
+	retries := flag.Int("retries", 3, "how often a failed page fetch is retried")
+	retryBackoff := flag.Duration("retry-backoff", 2*time.Second, "wait before the first retry; it doubles after every failure")
+	minShare := flag.Float64("min-share", 0.5, "refuse to save a snapshot with fewer stocks than this share of the latest saved one; 0 disables")
+	datasets := flag.String("datasets", "broker_summary,index_constituents,dividends,stock_splits,rights_issues", "comma-separated datasets to scrape after the snapshot; empty scrapes none")
+	indices := flag.String("indices", strings.Join(scraper.DefaultIndices(), ","), "comma-separated indices whose constituents are scraped")
+	daemon := flag.Bool("daemon", false, "keep running and scrape after the close of every trading day")
+	holidays := flag.String("holidays", "./config/idx_holidays.yaml", "exchange holiday calendar for -daemon; empty treats every weekday as a trading day")
+	runAt := flag.String("run-at", "16:30", "time of day (WIB) the -daemon scrapes at")
+	historyPath := flag.String("history", "./output/scrape_history.jsonl", "run history file for -daemon")
+	statusAddr := flag.String("status-addr", ":8081", "address of the -daemon HTTP status endpoint (GET /status); empty disables it")
+	notifyEngine := flag.String("notify-engine", "", "market engine gRPC address to ask to reload reference prices after each -daemon scrape")
+	notifyAPIKey := flag.String("notify-api-key", "", "API key sent with -notify-engine reloads to an engine that requires credentials")
+	notifyTokenFile := flag.String("notify-token-file", "", "file holding a JWT sent as a bearer token with -notify-engine reloads; read before every reload")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	repo, err := repository.Open(*storage, *dataDir, *sqlitePath, utils.NumberFormatIDX)
+	if err != nil {
+		log.Fatalf("Failed to open stock repository: %v", err)
+	}
+
+	var fetcher scraper.Fetcher
+	switch *fetcherKind {
+	case "http":
+		fetcher = scraper.NewHTTPFetcher()
+	case "selenium":
+		options := scraper.DefaultSeleniumOptions()
+		options.ChromePath = *chromePath
+		options.ChromeDriverPath = *chromeDriverPath
+		options.Port = *seleniumPort
+
+		fetcher, err = scraper.NewSeleniumFetcher(options)
+		if err != nil {
+			log.Fatalf("Failed to start Selenium: %v", err)
+		}
+	case "fixture":
+		fetcher = scraper.NewFixtureFetcher(*fixtureDir)
+	default:
+		log.Fatalf("Unknown fetcher %q", *fetcherKind)
+	}
+	defer fetcher.Close()
+
+	scrape := scraper.NewScraper(fetcher, repo)
+	scrape.Retry.Attempts = *retries + 1
+	scrape.Retry.InitialBackoff = *retryBackoff
+	scrape.MinShare = *minShare
+	scrape.Datasets = nil
+	for _, name := range splitList(*datasets) {
+		dataset, err := scraper.ParseDataset(name)
+		if err != nil {
+			log.Fatalf("Invalid -datasets: %v", err)
+		}
+		scrape.Datasets = append(scrape.Datasets, dataset)
+	}
+	scrape.Indices = splitList(*indices)
+
+	var notifier scraper.Notifier
+	if *notifyEngine != "" {
+		if *notifyAPIKey != "" && *notifyTokenFile != "" {
+			log.Fatalf("-notify-api-key and -notify-token-file cannot both be set")
+		}
+		notifier = scraper.EngineNotifier{Address: *notifyEngine, APIKey: *notifyAPIKey, TokenFile: *notifyTokenFile}
+	}
+
+	if *daemon {
+		runDaemon(ctx, scrape, *holidays, *runAt, *historyPath, *statusAddr, notifier)
+		return
+	}
+
+	result, err := scrape.Run(ctx, time.Now())
+	if len(result.Unmatched) > 0 {
+		log.Printf("Listed without a summary row: %s", strings.Join(result.Unmatched, ", "))
+	}
+	if len(result.Unlisted) > 0 {
+		log.Printf("In the summary but not listed: %s", strings.Join(result.Unlisted, ", "))
+	}
+	for _, problem := range result.ParseErrors {
+		log.Printf("Skipped %s", problem)
+	}
+	for _, problem := range result.DatasetErrors {
+		log.Printf("Dataset not saved: %s", problem)
+	}
+
+	if err != nil {
+		log.Printf("Scrape failed: %v", err)
+		fetcher.Close()
+		os.Exit(1)
+	}
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func runDaemon(ctx context.Context, scrape *scraper.Scraper, holidays string, runAt string, historyPath string, statusAddr string, notifier scraper.Notifier) {
+	tradingCalendar := calendar.Weekdays()
+	if holidays != "" {
+		loaded, err := calendar.Load(holidays)
+		if err != nil {
+			log.Fatalf("Failed to load holiday calendar: %v", err)
+		}
+		tradingCalendar = loaded
+	}
+
+	at, err := time.Parse("15:04", runAt)
+	if err != nil {
+		log.Fatalf("Invalid run time %q: %v", runAt, err)
+	}
+
+	history, err := scraper.OpenHistory(historyPath)
+	if err != nil {
+		log.Fatalf("Failed to read run history: %v", err)
+	}
+
+	options := scraper.DefaultDaemonOptions()
+	options.RunAt = time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute
+
+	daemon := scraper.NewDaemon(scrape, tradingCalendar, history, options)
+	daemon.Notifier = notifier
+
+	if statusAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/status", daemon)
+
+		server := &http.Server{Addr: statusAddr, Handler: mux}
+		go func() {
+			<-ctx.Done()
+			server.Close()
+		}()
+
+		go func() {
+			log.Printf("Status endpoint listening on %s", statusAddr)
+			if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("Failed to serve status: %v", err)
+			}
+		}()
+	}
+
+	log.Println("Scrape daemon starting")
+	daemon.Run(ctx)
 }
@@ -0,0 +1,2230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: market/v1/account.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccountUpdateReason int32
+
+const (
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_UNSPECIFIED AccountUpdateReason = 0
+	// The account's state when the stream starts.
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_SNAPSHOT AccountUpdateReason = 1
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_OPENED   AccountUpdateReason = 2
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_FUNDED   AccountUpdateReason = 3
+	// An order was placed, cancelled or adjusted, changing what it holds.
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_ORDER            AccountUpdateReason = 4
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_FILL             AccountUpdateReason = 5
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_CORPORATE_ACTION AccountUpdateReason = 6
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_WITHDRAWN        AccountUpdateReason = 7
+	// The end-of-day settlement run settled fills.
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_SETTLED     AccountUpdateReason = 8
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_MARGIN_CALL AccountUpdateReason = 9
+	// The account is back above the maintenance requirement.
+	AccountUpdateReason_ACCOUNT_UPDATE_REASON_MARGIN_CALL_MET AccountUpdateReason = 10
+)
+
+// Enum value maps for AccountUpdateReason.
+var (
+	AccountUpdateReason_name = map[int32]string{
+		0:  "ACCOUNT_UPDATE_REASON_UNSPECIFIED",
+		1:  "ACCOUNT_UPDATE_REASON_SNAPSHOT",
+		2:  "ACCOUNT_UPDATE_REASON_OPENED",
+		3:  "ACCOUNT_UPDATE_REASON_FUNDED",
+		4:  "ACCOUNT_UPDATE_REASON_ORDER",
+		5:  "ACCOUNT_UPDATE_REASON_FILL",
+		6:  "ACCOUNT_UPDATE_REASON_CORPORATE_ACTION",
+		7:  "ACCOUNT_UPDATE_REASON_WITHDRAWN",
+		8:  "ACCOUNT_UPDATE_REASON_SETTLED",
+		9:  "ACCOUNT_UPDATE_REASON_MARGIN_CALL",
+		10: "ACCOUNT_UPDATE_REASON_MARGIN_CALL_MET",
+	}
+	AccountUpdateReason_value = map[string]int32{
+		"ACCOUNT_UPDATE_REASON_UNSPECIFIED":      0,
+		"ACCOUNT_UPDATE_REASON_SNAPSHOT":         1,
+		"ACCOUNT_UPDATE_REASON_OPENED":           2,
+		"ACCOUNT_UPDATE_REASON_FUNDED":           3,
+		"ACCOUNT_UPDATE_REASON_ORDER":            4,
+		"ACCOUNT_UPDATE_REASON_FILL":             5,
+		"ACCOUNT_UPDATE_REASON_CORPORATE_ACTION": 6,
+		"ACCOUNT_UPDATE_REASON_WITHDRAWN":        7,
+		"ACCOUNT_UPDATE_REASON_SETTLED":          8,
+		"ACCOUNT_UPDATE_REASON_MARGIN_CALL":      9,
+		"ACCOUNT_UPDATE_REASON_MARGIN_CALL_MET":  10,
+	}
+)
+
+func (x AccountUpdateReason) Enum() *AccountUpdateReason {
+	p := new(AccountUpdateReason)
+	*p = x
+	return p
+}
+
+func (x AccountUpdateReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountUpdateReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_account_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountUpdateReason) Type() protoreflect.EnumType {
+	return &file_market_v1_account_proto_enumTypes[0]
+}
+
+func (x AccountUpdateReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountUpdateReason.Descriptor instead.
+func (AccountUpdateReason) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
+// Cash and quantities are trade-date balances, which count fills from the
+// day they trade; settled balances count them once they settle. A margin
+// account's cash is negative while it borrows.
+type Account struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cash  float64                `protobuf:"fixed64,3,opt,name=cash,proto3" json:"cash,omitempty"`
+	// Cash held for open buy orders and their fees.
+	ReservedCash float64 `protobuf:"fixed64,4,opt,name=reserved_cash,json=reservedCash,proto3" json:"reserved_cash,omitempty"`
+	// Cash less reserved cash, and less unsettled proceeds when the engine
+	// does not let them be spent before they settle. On a margin account,
+	// the value of marginable stock its excess equity covers.
+	BuyingPower float64 `protobuf:"fixed64,5,opt,name=buying_power,json=buyingPower,proto3" json:"buying_power,omitempty"`
+	CreatedAt   int64   `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Positions valued at last prices.
+	MarketValue float64 `protobuf:"fixed64,7,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	// Cash plus market value.
+	Equity float64 `protobuf:"fixed64,8,opt,name=equity,proto3" json:"equity,omitempty"`
+	// Net of fees.
+	RealizedPnl   float64 `protobuf:"fixed64,9,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64 `protobuf:"fixed64,10,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	// Fee tier, which sets the commission charged.
+	Tier string `protobuf:"bytes,11,opt,name=tier,proto3" json:"tier,omitempty"`
+	// Every fee and tax charged on fills.
+	FeesPaid    float64 `protobuf:"fixed64,12,opt,name=fees_paid,json=feesPaid,proto3" json:"fees_paid,omitempty"`
+	SettledCash float64 `protobuf:"fixed64,13,opt,name=settled_cash,json=settledCash,proto3" json:"settled_cash,omitempty"`
+	// Sale proceeds, net of fees, that have not settled.
+	UnsettledProceeds float64 `protobuf:"fixed64,14,opt,name=unsettled_proceeds,json=unsettledProceeds,proto3" json:"unsettled_proceeds,omitempty"`
+	// Settled cash not reserved for open orders, and on a margin account no
+	// more than its excess equity.
+	Withdrawable float64 `protobuf:"fixed64,15,opt,name=withdrawable,proto3" json:"withdrawable,omitempty"`
+	Margin       bool    `protobuf:"varint,16,opt,name=margin,proto3" json:"margin,omitempty"`
+	// Set on margin accounts.
+	MarginStatus *MarginStatus `protobuf:"bytes,17,opt,name=margin_status,json=marginStatus,proto3" json:"margin_status,omitempty"`
+	// Subject of the caller who opened the account; empty when the engine
+	// runs without authentication.
+	Owner         string `protobuf:"bytes,18,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_market_v1_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *Account) GetReservedCash() float64 {
+	if x != nil {
+		return x.ReservedCash
+	}
+	return 0
+}
+
+func (x *Account) GetBuyingPower() float64 {
+	if x != nil {
+		return x.BuyingPower
+	}
+	return 0
+}
+
+func (x *Account) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Account) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *Account) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *Account) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *Account) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Account) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *Account) GetFeesPaid() float64 {
+	if x != nil {
+		return x.FeesPaid
+	}
+	return 0
+}
+
+func (x *Account) GetSettledCash() float64 {
+	if x != nil {
+		return x.SettledCash
+	}
+	return 0
+}
+
+func (x *Account) GetUnsettledProceeds() float64 {
+	if x != nil {
+		return x.UnsettledProceeds
+	}
+	return 0
+}
+
+func (x *Account) GetWithdrawable() float64 {
+	if x != nil {
+		return x.Withdrawable
+	}
+	return 0
+}
+
+func (x *Account) GetMargin() bool {
+	if x != nil {
+		return x.Margin
+	}
+	return false
+}
+
+func (x *Account) GetMarginStatus() *MarginStatus {
+	if x != nil {
+		return x.MarginStatus
+	}
+	return nil
+}
+
+func (x *Account) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// A margin account valued at last prices. Requirements are the equity its
+// positions need at the initial, maintenance and liquidation ratios.
+type MarginStatus struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	LongValue float64                `protobuf:"fixed64,1,opt,name=long_value,json=longValue,proto3" json:"long_value,omitempty"`
+	// The market value of short positions, as a positive amount.
+	ShortValue             float64 `protobuf:"fixed64,2,opt,name=short_value,json=shortValue,proto3" json:"short_value,omitempty"`
+	InitialRequirement     float64 `protobuf:"fixed64,3,opt,name=initial_requirement,json=initialRequirement,proto3" json:"initial_requirement,omitempty"`
+	MaintenanceRequirement float64 `protobuf:"fixed64,4,opt,name=maintenance_requirement,json=maintenanceRequirement,proto3" json:"maintenance_requirement,omitempty"`
+	LiquidationRequirement float64 `protobuf:"fixed64,5,opt,name=liquidation_requirement,json=liquidationRequirement,proto3" json:"liquidation_requirement,omitempty"`
+	// Equity above the initial requirement not held for open orders.
+	ExcessEquity float64 `protobuf:"fixed64,6,opt,name=excess_equity,json=excessEquity,proto3" json:"excess_equity,omitempty"`
+	// Set while the account is in a margin call.
+	MarginCall    *MarginCall `protobuf:"bytes,7,opt,name=margin_call,json=marginCall,proto3" json:"margin_call,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarginStatus) Reset() {
+	*x = MarginStatus{}
+	mi := &file_market_v1_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginStatus) ProtoMessage() {}
+
+func (x *MarginStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginStatus.ProtoReflect.Descriptor instead.
+func (*MarginStatus) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarginStatus) GetLongValue() float64 {
+	if x != nil {
+		return x.LongValue
+	}
+	return 0
+}
+
+func (x *MarginStatus) GetShortValue() float64 {
+	if x != nil {
+		return x.ShortValue
+	}
+	return 0
+}
+
+func (x *MarginStatus) GetInitialRequirement() float64 {
+	if x != nil {
+		return x.InitialRequirement
+	}
+	return 0
+}
+
+func (x *MarginStatus) GetMaintenanceRequirement() float64 {
+	if x != nil {
+		return x.MaintenanceRequirement
+	}
+	return 0
+}
+
+func (x *MarginStatus) GetLiquidationRequirement() float64 {
+	if x != nil {
+		return x.LiquidationRequirement
+	}
+	return 0
+}
+
+func (x *MarginStatus) GetExcessEquity() float64 {
+	if x != nil {
+		return x.ExcessEquity
+	}
+	return 0
+}
+
+func (x *MarginStatus) GetMarginCall() *MarginCall {
+	if x != nil {
+		return x.MarginCall
+	}
+	return nil
+}
+
+// A demand to bring equity back up to the maintenance requirement by the
+// deadline, after which positions are liquidated through the book.
+type MarginCall struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	IssuedAt int64                  `protobuf:"varint,1,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Deadline int64                  `protobuf:"varint,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Equity and maintenance requirement when the call was issued.
+	Equity        float64 `protobuf:"fixed64,3,opt,name=equity,proto3" json:"equity,omitempty"`
+	Requirement   float64 `protobuf:"fixed64,4,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarginCall) Reset() {
+	*x = MarginCall{}
+	mi := &file_market_v1_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginCall) ProtoMessage() {}
+
+func (x *MarginCall) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginCall.ProtoReflect.Descriptor instead.
+func (*MarginCall) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MarginCall) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *MarginCall) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *MarginCall) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *MarginCall) GetRequirement() float64 {
+	if x != nil {
+		return x.Requirement
+	}
+	return 0
+}
+
+type Position struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Negative for a short position.
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Shares held for open sell orders.
+	ReservedQuantity int64 `protobuf:"varint,3,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	// Includes buy fees; for a short position, the average sale price net of
+	// fees.
+	AverageCost   float64 `protobuf:"fixed64,4,opt,name=average_cost,json=averageCost,proto3" json:"average_cost,omitempty"`
+	LastPrice     float64 `protobuf:"fixed64,5,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	MarketValue   float64 `protobuf:"fixed64,6,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	UnrealizedPnl float64 `protobuf:"fixed64,7,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	// Net of sell fees; includes cash dividends received.
+	RealizedPnl     float64 `protobuf:"fixed64,8,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	SettledQuantity int64   `protobuf:"varint,9,opt,name=settled_quantity,json=settledQuantity,proto3" json:"settled_quantity,omitempty"`
+	// IDX-IC sector, or Unclassified.
+	Sector        string `protobuf:"bytes,10,opt,name=sector,proto3" json:"sector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_market_v1_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Position) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Position) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Position) GetReservedQuantity() int64 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *Position) GetAverageCost() float64 {
+	if x != nil {
+		return x.AverageCost
+	}
+	return 0
+}
+
+func (x *Position) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *Position) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *Position) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Position) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *Position) GetSettledQuantity() int64 {
+	if x != nil {
+		return x.SettledQuantity
+	}
+	return 0
+}
+
+func (x *Position) GetSector() string {
+	if x != nil {
+		return x.Sector
+	}
+	return ""
+}
+
+// A fill's cash and shares awaiting settlement, as they change the account:
+// positive for a sell's proceeds and a buy's shares, negative for a buy's
+// payment and a sell's shares.
+type Settlement struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TradeId  string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Symbol   string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side     OrderSide              `protobuf:"varint,3,opt,name=side,proto3,enum=market.v1.OrderSide" json:"side,omitempty"`
+	Quantity int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Net of fees.
+	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Dates as YYYY-MM-DD.
+	TradeDate      string `protobuf:"bytes,6,opt,name=trade_date,json=tradeDate,proto3" json:"trade_date,omitempty"`
+	SettlementDate string `protobuf:"bytes,7,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_market_v1_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Settlement) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *Settlement) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Settlement) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *Settlement) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Settlement) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Settlement) GetTradeDate() string {
+	if x != nil {
+		return x.TradeDate
+	}
+	return ""
+}
+
+func (x *Settlement) GetSettlementDate() string {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return ""
+}
+
+type CreateAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Opening cash balance.
+	Cash float64 `protobuf:"fixed64,2,opt,name=cash,proto3" json:"cash,omitempty"`
+	// Fee tier; empty opens the account on the default tier.
+	Tier string `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	// Opens a margin account, which can borrow against its positions and
+	// sell short.
+	Margin        bool `protobuf:"varint,4,opt,name=margin,proto3" json:"margin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetMargin() bool {
+	if x != nil {
+		return x.Margin
+	}
+	return false
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type FundAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundAccountRequest) Reset() {
+	*x = FundAccountRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundAccountRequest) ProtoMessage() {}
+
+func (x *FundAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundAccountRequest.ProtoReflect.Descriptor instead.
+func (*FundAccountRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FundAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *FundAccountRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type FundAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundAccountResponse) Reset() {
+	*x = FundAccountResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundAccountResponse) ProtoMessage() {}
+
+func (x *FundAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundAccountResponse.ProtoReflect.Descriptor instead.
+func (*FundAccountResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FundAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type WithdrawFundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawFundsRequest) Reset() {
+	*x = WithdrawFundsRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawFundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawFundsRequest) ProtoMessage() {}
+
+func (x *WithdrawFundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawFundsRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawFundsRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WithdrawFundsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *WithdrawFundsRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type WithdrawFundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawFundsResponse) Reset() {
+	*x = WithdrawFundsResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawFundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawFundsResponse) ProtoMessage() {}
+
+func (x *WithdrawFundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawFundsResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawFundsResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WithdrawFundsResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetAccountResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Account   *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Positions []*Position            `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Fills awaiting settlement, oldest first.
+	Unsettled     []*Settlement `protobuf:"bytes,3,rep,name=unsettled,proto3" json:"unsettled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetAccountResponse) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *GetAccountResponse) GetUnsettled() []*Settlement {
+	if x != nil {
+		return x.Unsettled
+	}
+	return nil
+}
+
+type ListPositionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Also returns positions that have been sold down to zero, which still
+	// carry realized P&L.
+	IncludeClosed bool `protobuf:"varint,2,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPositionsRequest) Reset() {
+	*x = ListPositionsRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPositionsRequest) ProtoMessage() {}
+
+func (x *ListPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPositionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPositionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListPositionsRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+type ListPositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*Position            `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPositionsResponse) Reset() {
+	*x = ListPositionsResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPositionsResponse) ProtoMessage() {}
+
+func (x *ListPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPositionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListPositionsResponse) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Filters; unset ones match every order.
+	Symbol string      `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Status OrderStatus `protobuf:"varint,3,opt,name=status,proto3,enum=market.v1.OrderStatus" json:"status,omitempty"`
+	Side   OrderSide   `protobuf:"varint,4,opt,name=side,proto3,enum=market.v1.OrderSide" json:"side,omitempty"`
+	// Inclusive bounds on the WIB day the order was placed, as YYYY-MM-DD;
+	// empty bounds are open.
+	FromDate string `protobuf:"bytes,5,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate   string `protobuf:"bytes,6,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	// Orders per page, 100 when zero and at most 1000.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, with the same filters.
+	PageToken     string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOrdersRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *ListOrdersRequest) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *ListOrdersRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// An order as it last stood.
+type AccountOrder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rejected orders have no id.
+	Order *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// Average price of the filled quantity.
+	AveragePrice float64           `protobuf:"fixed64,2,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	RejectReason OrderRejectReason `protobuf:"varint,3,opt,name=reject_reason,json=rejectReason,proto3,enum=market.v1.OrderRejectReason" json:"reject_reason,omitempty"`
+	// Why the order was rejected, cancelled or replaced, when the engine
+	// says.
+	Text          string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountOrder) Reset() {
+	*x = AccountOrder{}
+	mi := &file_market_v1_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOrder) ProtoMessage() {}
+
+func (x *AccountOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOrder.ProtoReflect.Descriptor instead.
+func (*AccountOrder) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AccountOrder) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *AccountOrder) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *AccountOrder) GetRejectReason() OrderRejectReason {
+	if x != nil {
+		return x.RejectReason
+	}
+	return OrderRejectReason_ORDER_REJECT_REASON_UNSPECIFIED
+}
+
+func (x *AccountOrder) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*AccountOrder        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*AccountOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListMyTradesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Filters; unset ones match every fill.
+	Symbol string    `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side   OrderSide `protobuf:"varint,3,opt,name=side,proto3,enum=market.v1.OrderSide" json:"side,omitempty"`
+	// Inclusive bounds on the WIB day of the fill, as YYYY-MM-DD; empty
+	// bounds are open.
+	FromDate string `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate   string `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	// Fills per page, 100 when zero and at most 1000.
+	PageSize int32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, with the same filters.
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTradesRequest) Reset() {
+	*x = ListMyTradesRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTradesRequest) ProtoMessage() {}
+
+func (x *ListMyTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTradesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTradesRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMyTradesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListMyTradesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListMyTradesRequest) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *ListMyTradesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListMyTradesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ListMyTradesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyTradesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// The account's side of a fill.
+type AccountTrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side          OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=market.v1.OrderSide" json:"side,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Fees          *Fees                  `protobuf:"bytes,7,opt,name=fees,proto3" json:"fees,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountTrade) Reset() {
+	*x = AccountTrade{}
+	mi := &file_market_v1_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountTrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTrade) ProtoMessage() {}
+
+func (x *AccountTrade) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTrade.ProtoReflect.Descriptor instead.
+func (*AccountTrade) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AccountTrade) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *AccountTrade) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AccountTrade) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AccountTrade) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *AccountTrade) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AccountTrade) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AccountTrade) GetFees() *Fees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *AccountTrade) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ListMyTradesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Trades []*AccountTrade        `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTradesResponse) Reset() {
+	*x = ListMyTradesResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTradesResponse) ProtoMessage() {}
+
+func (x *ListMyTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTradesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTradesResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListMyTradesResponse) GetTrades() []*AccountTrade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+func (x *ListMyTradesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamAccountUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamAccountUpdatesRequest) Reset() {
+	*x = StreamAccountUpdatesRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAccountUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAccountUpdatesRequest) ProtoMessage() {}
+
+func (x *StreamAccountUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAccountUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*StreamAccountUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamAccountUpdatesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type StreamAccountUpdatesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Reason    AccountUpdateReason    `protobuf:"varint,1,opt,name=reason,proto3,enum=market.v1.AccountUpdateReason" json:"reason,omitempty"`
+	Timestamp int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Account   *Account               `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// The positions that changed; every position on SNAPSHOT updates.
+	Positions []*Position `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Set on FILL updates, with the fees the account paid.
+	Trade         *Trade `protobuf:"bytes,5,opt,name=trade,proto3" json:"trade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamAccountUpdatesResponse) Reset() {
+	*x = StreamAccountUpdatesResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAccountUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAccountUpdatesResponse) ProtoMessage() {}
+
+func (x *StreamAccountUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAccountUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*StreamAccountUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StreamAccountUpdatesResponse) GetReason() AccountUpdateReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AccountUpdateReason_ACCOUNT_UPDATE_REASON_UNSPECIFIED
+}
+
+func (x *StreamAccountUpdatesResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *StreamAccountUpdatesResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *StreamAccountUpdatesResponse) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *StreamAccountUpdatesResponse) GetTrade() *Trade {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+type GetMarginSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginSettingsRequest) Reset() {
+	*x = GetMarginSettingsRequest{}
+	mi := &file_market_v1_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginSettingsRequest) ProtoMessage() {}
+
+func (x *GetMarginSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{23}
+}
+
+type GetMarginSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Equity as a fraction of the value of positions in marginable symbols;
+	// other positions must be paid for in full.
+	InitialRatio     float64 `protobuf:"fixed64,1,opt,name=initial_ratio,json=initialRatio,proto3" json:"initial_ratio,omitempty"`
+	MaintenanceRatio float64 `protobuf:"fixed64,2,opt,name=maintenance_ratio,json=maintenanceRatio,proto3" json:"maintenance_ratio,omitempty"`
+	// Zero when positions are only liquidated once a call's deadline passes.
+	LiquidationRatio float64 `protobuf:"fixed64,3,opt,name=liquidation_ratio,json=liquidationRatio,proto3" json:"liquidation_ratio,omitempty"`
+	// How long a margin call gives an account, on the engine clock.
+	CallGraceMs   int64    `protobuf:"varint,4,opt,name=call_grace_ms,json=callGraceMs,proto3" json:"call_grace_ms,omitempty"`
+	ShortSelling  bool     `protobuf:"varint,5,opt,name=short_selling,json=shortSelling,proto3" json:"short_selling,omitempty"`
+	Marginable    []string `protobuf:"bytes,6,rep,name=marginable,proto3" json:"marginable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginSettingsResponse) Reset() {
+	*x = GetMarginSettingsResponse{}
+	mi := &file_market_v1_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginSettingsResponse) ProtoMessage() {}
+
+func (x *GetMarginSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMarginSettingsResponse) GetInitialRatio() float64 {
+	if x != nil {
+		return x.InitialRatio
+	}
+	return 0
+}
+
+func (x *GetMarginSettingsResponse) GetMaintenanceRatio() float64 {
+	if x != nil {
+		return x.MaintenanceRatio
+	}
+	return 0
+}
+
+func (x *GetMarginSettingsResponse) GetLiquidationRatio() float64 {
+	if x != nil {
+		return x.LiquidationRatio
+	}
+	return 0
+}
+
+func (x *GetMarginSettingsResponse) GetCallGraceMs() int64 {
+	if x != nil {
+		return x.CallGraceMs
+	}
+	return 0
+}
+
+func (x *GetMarginSettingsResponse) GetShortSelling() bool {
+	if x != nil {
+		return x.ShortSelling
+	}
+	return false
+}
+
+func (x *GetMarginSettingsResponse) GetMarginable() []string {
+	if x != nil {
+		return x.Marginable
+	}
+	return nil
+}
+
+var File_market_v1_account_proto protoreflect.FileDescriptor
+
+const file_market_v1_account_proto_rawDesc = "" +
+	"\n" +
+	"\x17market/v1/account.proto\x12\tmarket.v1\x1a\x16market/v1/market.proto\"\xc0\x04\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cash\x18\x03 \x01(\x01R\x04cash\x12#\n" +
+	"\rreserved_cash\x18\x04 \x01(\x01R\freservedCash\x12!\n" +
+	"\fbuying_power\x18\x05 \x01(\x01R\vbuyingPower\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fmarket_value\x18\a \x01(\x01R\vmarketValue\x12\x16\n" +
+	"\x06equity\x18\b \x01(\x01R\x06equity\x12!\n" +
+	"\frealized_pnl\x18\t \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\n" +
+	" \x01(\x01R\runrealizedPnl\x12\x12\n" +
+	"\x04tier\x18\v \x01(\tR\x04tier\x12\x1b\n" +
+	"\tfees_paid\x18\f \x01(\x01R\bfeesPaid\x12!\n" +
+	"\fsettled_cash\x18\r \x01(\x01R\vsettledCash\x12-\n" +
+	"\x12unsettled_proceeds\x18\x0e \x01(\x01R\x11unsettledProceeds\x12\"\n" +
+	"\fwithdrawable\x18\x0f \x01(\x01R\fwithdrawable\x12\x16\n" +
+	"\x06margin\x18\x10 \x01(\bR\x06margin\x12<\n" +
+	"\rmargin_status\x18\x11 \x01(\v2\x17.market.v1.MarginStatusR\fmarginStatus\x12\x14\n" +
+	"\x05owner\x18\x12 \x01(\tR\x05owner\"\xce\x02\n" +
+	"\fMarginStatus\x12\x1d\n" +
+	"\n" +
+	"long_value\x18\x01 \x01(\x01R\tlongValue\x12\x1f\n" +
+	"\vshort_value\x18\x02 \x01(\x01R\n" +
+	"shortValue\x12/\n" +
+	"\x13initial_requirement\x18\x03 \x01(\x01R\x12initialRequirement\x127\n" +
+	"\x17maintenance_requirement\x18\x04 \x01(\x01R\x16maintenanceRequirement\x127\n" +
+	"\x17liquidation_requirement\x18\x05 \x01(\x01R\x16liquidationRequirement\x12#\n" +
+	"\rexcess_equity\x18\x06 \x01(\x01R\fexcessEquity\x126\n" +
+	"\vmargin_call\x18\a \x01(\v2\x15.market.v1.MarginCallR\n" +
+	"marginCall\"\x7f\n" +
+	"\n" +
+	"MarginCall\x12\x1b\n" +
+	"\tissued_at\x18\x01 \x01(\x03R\bissuedAt\x12\x1a\n" +
+	"\bdeadline\x18\x02 \x01(\x03R\bdeadline\x12\x16\n" +
+	"\x06equity\x18\x03 \x01(\x01R\x06equity\x12 \n" +
+	"\vrequirement\x18\x04 \x01(\x01R\vrequirement\"\xdd\x02\n" +
+	"\bPosition\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12+\n" +
+	"\x11reserved_quantity\x18\x03 \x01(\x03R\x10reservedQuantity\x12!\n" +
+	"\faverage_cost\x18\x04 \x01(\x01R\vaverageCost\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x05 \x01(\x01R\tlastPrice\x12!\n" +
+	"\fmarket_value\x18\x06 \x01(\x01R\vmarketValue\x12%\n" +
+	"\x0eunrealized_pnl\x18\a \x01(\x01R\runrealizedPnl\x12!\n" +
+	"\frealized_pnl\x18\b \x01(\x01R\vrealizedPnl\x12)\n" +
+	"\x10settled_quantity\x18\t \x01(\x03R\x0fsettledQuantity\x12\x16\n" +
+	"\x06sector\x18\n" +
+	" \x01(\tR\x06sector\"\xe5\x01\n" +
+	"\n" +
+	"Settlement\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12(\n" +
+	"\x04side\x18\x03 \x01(\x0e2\x14.market.v1.OrderSideR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"trade_date\x18\x06 \x01(\tR\ttradeDate\x12'\n" +
+	"\x0fsettlement_date\x18\a \x01(\tR\x0esettlementDate\"j\n" +
+	"\x14CreateAccountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cash\x18\x02 \x01(\x01R\x04cash\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\tR\x04tier\x12\x16\n" +
+	"\x06margin\x18\x04 \x01(\bR\x06margin\"E\n" +
+	"\x15CreateAccountResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.market.v1.AccountR\aaccount\"K\n" +
+	"\x12FundAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"C\n" +
+	"\x13FundAccountResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.market.v1.AccountR\aaccount\"M\n" +
+	"\x14WithdrawFundsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"E\n" +
+	"\x15WithdrawFundsResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.market.v1.AccountR\aaccount\"2\n" +
+	"\x11GetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"\xaa\x01\n" +
+	"\x12GetAccountResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.market.v1.AccountR\aaccount\x121\n" +
+	"\tpositions\x18\x02 \x03(\v2\x13.market.v1.PositionR\tpositions\x123\n" +
+	"\tunsettled\x18\x03 \x03(\v2\x15.market.v1.SettlementR\tunsettled\"\\\n" +
+	"\x14ListPositionsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12%\n" +
+	"\x0einclude_closed\x18\x02 \x01(\bR\rincludeClosed\"J\n" +
+	"\x15ListPositionsResponse\x121\n" +
+	"\tpositions\x18\x01 \x03(\v2\x13.market.v1.PositionR\tpositions\"\x96\x02\n" +
+	"\x11ListOrdersRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.market.v1.OrderStatusR\x06status\x12(\n" +
+	"\x04side\x18\x04 \x01(\x0e2\x14.market.v1.OrderSideR\x04side\x12\x1b\n" +
+	"\tfrom_date\x18\x05 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x06 \x01(\tR\x06toDate\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\"\xb2\x01\n" +
+	"\fAccountOrder\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.market.v1.OrderR\x05order\x12#\n" +
+	"\raverage_price\x18\x02 \x01(\x01R\faveragePrice\x12A\n" +
+	"\rreject_reason\x18\x03 \x01(\x0e2\x1c.market.v1.OrderRejectReasonR\frejectReason\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\"m\n" +
+	"\x12ListOrdersResponse\x12/\n" +
+	"\x06orders\x18\x01 \x03(\v2\x17.market.v1.AccountOrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe8\x01\n" +
+	"\x13ListMyTradesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12(\n" +
+	"\x04side\x18\x03 \x01(\x0e2\x14.market.v1.OrderSideR\x04side\x12\x1b\n" +
+	"\tfrom_date\x18\x04 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x05 \x01(\tR\x06toDate\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\xfb\x01\n" +
+	"\fAccountTrade\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12(\n" +
+	"\x04side\x18\x04 \x01(\x0e2\x14.market.v1.OrderSideR\x04side\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12#\n" +
+	"\x04fees\x18\a \x01(\v2\x0f.market.v1.FeesR\x04fees\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\x03R\ttimestamp\"o\n" +
+	"\x14ListMyTradesResponse\x12/\n" +
+	"\x06trades\x18\x01 \x03(\v2\x17.market.v1.AccountTradeR\x06trades\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"<\n" +
+	"\x1bStreamAccountUpdatesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"\xfd\x01\n" +
+	"\x1cStreamAccountUpdatesResponse\x126\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x1e.market.v1.AccountUpdateReasonR\x06reason\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12,\n" +
+	"\aaccount\x18\x03 \x01(\v2\x12.market.v1.AccountR\aaccount\x121\n" +
+	"\tpositions\x18\x04 \x03(\v2\x13.market.v1.PositionR\tpositions\x12&\n" +
+	"\x05trade\x18\x05 \x01(\v2\x10.market.v1.TradeR\x05trade\"\x1a\n" +
+	"\x18GetMarginSettingsRequest\"\x83\x02\n" +
+	"\x19GetMarginSettingsResponse\x12#\n" +
+	"\rinitial_ratio\x18\x01 \x01(\x01R\finitialRatio\x12+\n" +
+	"\x11maintenance_ratio\x18\x02 \x01(\x01R\x10maintenanceRatio\x12+\n" +
+	"\x11liquidation_ratio\x18\x03 \x01(\x01R\x10liquidationRatio\x12\"\n" +
+	"\rcall_grace_ms\x18\x04 \x01(\x03R\vcallGraceMs\x12#\n" +
+	"\rshort_selling\x18\x05 \x01(\bR\fshortSelling\x12\x1e\n" +
+	"\n" +
+	"marginable\x18\x06 \x03(\tR\n" +
+	"marginable*\xab\x03\n" +
+	"\x13AccountUpdateReason\x12%\n" +
+	"!ACCOUNT_UPDATE_REASON_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eACCOUNT_UPDATE_REASON_SNAPSHOT\x10\x01\x12 \n" +
+	"\x1cACCOUNT_UPDATE_REASON_OPENED\x10\x02\x12 \n" +
+	"\x1cACCOUNT_UPDATE_REASON_FUNDED\x10\x03\x12\x1f\n" +
+	"\x1bACCOUNT_UPDATE_REASON_ORDER\x10\x04\x12\x1e\n" +
+	"\x1aACCOUNT_UPDATE_REASON_FILL\x10\x05\x12*\n" +
+	"&ACCOUNT_UPDATE_REASON_CORPORATE_ACTION\x10\x06\x12#\n" +
+	"\x1fACCOUNT_UPDATE_REASON_WITHDRAWN\x10\a\x12!\n" +
+	"\x1dACCOUNT_UPDATE_REASON_SETTLED\x10\b\x12%\n" +
+	"!ACCOUNT_UPDATE_REASON_MARGIN_CALL\x10\t\x12)\n" +
+	"%ACCOUNT_UPDATE_REASON_MARGIN_CALL_MET\x10\n" +
+	"2\x9c\x06\n" +
+	"\x0eAccountService\x12T\n" +
+	"\rCreateAccount\x12\x1f.market.v1.CreateAccountRequest\x1a .market.v1.CreateAccountResponse\"\x00\x12N\n" +
+	"\vFundAccount\x12\x1d.market.v1.FundAccountRequest\x1a\x1e.market.v1.FundAccountResponse\"\x00\x12T\n" +
+	"\rWithdrawFunds\x12\x1f.market.v1.WithdrawFundsRequest\x1a .market.v1.WithdrawFundsResponse\"\x00\x12K\n" +
+	"\n" +
+	"GetAccount\x12\x1c.market.v1.GetAccountRequest\x1a\x1d.market.v1.GetAccountResponse\"\x00\x12T\n" +
+	"\rListPositions\x12\x1f.market.v1.ListPositionsRequest\x1a .market.v1.ListPositionsResponse\"\x00\x12i\n" +
+	"\x14StreamAccountUpdates\x12&.market.v1.StreamAccountUpdatesRequest\x1a'.market.v1.StreamAccountUpdatesResponse0\x01\x12`\n" +
+	"\x11GetMarginSettings\x12#.market.v1.GetMarginSettingsRequest\x1a$.market.v1.GetMarginSettingsResponse\"\x00\x12K\n" +
+	"\n" +
+	"ListOrders\x12\x1c.market.v1.ListOrdersRequest\x1a\x1d.market.v1.ListOrdersResponse\"\x00\x12Q\n" +
+	"\fListMyTrades\x12\x1e.market.v1.ListMyTradesRequest\x1a\x1f.market.v1.ListMyTradesResponse\"\x00B#Z!market-engine-go/gen/go/market/v1b\x06proto3"
+
+var (
+	file_market_v1_account_proto_rawDescOnce sync.Once
+	file_market_v1_account_proto_rawDescData []byte
+)
+
+func file_market_v1_account_proto_rawDescGZIP() []byte {
+	file_market_v1_account_proto_rawDescOnce.Do(func() {
+		file_market_v1_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_market_v1_account_proto_rawDesc), len(file_market_v1_account_proto_rawDesc)))
+	})
+	return file_market_v1_account_proto_rawDescData
+}
+
+var file_market_v1_account_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_market_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_market_v1_account_proto_goTypes = []any{
+	(AccountUpdateReason)(0),             // 0: market.v1.AccountUpdateReason
+	(*Account)(nil),                      // 1: market.v1.Account
+	(*MarginStatus)(nil),                 // 2: market.v1.MarginStatus
+	(*MarginCall)(nil),                   // 3: market.v1.MarginCall
+	(*Position)(nil),                     // 4: market.v1.Position
+	(*Settlement)(nil),                   // 5: market.v1.Settlement
+	(*CreateAccountRequest)(nil),         // 6: market.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),        // 7: market.v1.CreateAccountResponse
+	(*FundAccountRequest)(nil),           // 8: market.v1.FundAccountRequest
+	(*FundAccountResponse)(nil),          // 9: market.v1.FundAccountResponse
+	(*WithdrawFundsRequest)(nil),         // 10: market.v1.WithdrawFundsRequest
+	(*WithdrawFundsResponse)(nil),        // 11: market.v1.WithdrawFundsResponse
+	(*GetAccountRequest)(nil),            // 12: market.v1.GetAccountRequest
+	(*GetAccountResponse)(nil),           // 13: market.v1.GetAccountResponse
+	(*ListPositionsRequest)(nil),         // 14: market.v1.ListPositionsRequest
+	(*ListPositionsResponse)(nil),        // 15: market.v1.ListPositionsResponse
+	(*ListOrdersRequest)(nil),            // 16: market.v1.ListOrdersRequest
+	(*AccountOrder)(nil),                 // 17: market.v1.AccountOrder
+	(*ListOrdersResponse)(nil),           // 18: market.v1.ListOrdersResponse
+	(*ListMyTradesRequest)(nil),          // 19: market.v1.ListMyTradesRequest
+	(*AccountTrade)(nil),                 // 20: market.v1.AccountTrade
+	(*ListMyTradesResponse)(nil),         // 21: market.v1.ListMyTradesResponse
+	(*StreamAccountUpdatesRequest)(nil),  // 22: market.v1.StreamAccountUpdatesRequest
+	(*StreamAccountUpdatesResponse)(nil), // 23: market.v1.StreamAccountUpdatesResponse
+	(*GetMarginSettingsRequest)(nil),     // 24: market.v1.GetMarginSettingsRequest
+	(*GetMarginSettingsResponse)(nil),    // 25: market.v1.GetMarginSettingsResponse
+	(OrderSide)(0),                       // 26: market.v1.OrderSide
+	(OrderStatus)(0),                     // 27: market.v1.OrderStatus
+	(*Order)(nil),                        // 28: market.v1.Order
+	(OrderRejectReason)(0),               // 29: market.v1.OrderRejectReason
+	(*Fees)(nil),                         // 30: market.v1.Fees
+	(*Trade)(nil),                        // 31: market.v1.Trade
+}
+var file_market_v1_account_proto_depIdxs = []int32{
+	2,  // 0: market.v1.Account.margin_status:type_name -> market.v1.MarginStatus
+	3,  // 1: market.v1.MarginStatus.margin_call:type_name -> market.v1.MarginCall
+	26, // 2: market.v1.Settlement.side:type_name -> market.v1.OrderSide
+	1,  // 3: market.v1.CreateAccountResponse.account:type_name -> market.v1.Account
+	1,  // 4: market.v1.FundAccountResponse.account:type_name -> market.v1.Account
+	1,  // 5: market.v1.WithdrawFundsResponse.account:type_name -> market.v1.Account
+	1,  // 6: market.v1.GetAccountResponse.account:type_name -> market.v1.Account
+	4,  // 7: market.v1.GetAccountResponse.positions:type_name -> market.v1.Position
+	5,  // 8: market.v1.GetAccountResponse.unsettled:type_name -> market.v1.Settlement
+	4,  // 9: market.v1.ListPositionsResponse.positions:type_name -> market.v1.Position
+	27, // 10: market.v1.ListOrdersRequest.status:type_name -> market.v1.OrderStatus
+	26, // 11: market.v1.ListOrdersRequest.side:type_name -> market.v1.OrderSide
+	28, // 12: market.v1.AccountOrder.order:type_name -> market.v1.Order
+	29, // 13: market.v1.AccountOrder.reject_reason:type_name -> market.v1.OrderRejectReason
+	17, // 14: market.v1.ListOrdersResponse.orders:type_name -> market.v1.AccountOrder
+	26, // 15: market.v1.ListMyTradesRequest.side:type_name -> market.v1.OrderSide
+	26, // 16: market.v1.AccountTrade.side:type_name -> market.v1.OrderSide
+	30, // 17: market.v1.AccountTrade.fees:type_name -> market.v1.Fees
+	20, // 18: market.v1.ListMyTradesResponse.trades:type_name -> market.v1.AccountTrade
+	0,  // 19: market.v1.StreamAccountUpdatesResponse.reason:type_name -> market.v1.AccountUpdateReason
+	1,  // 20: market.v1.StreamAccountUpdatesResponse.account:type_name -> market.v1.Account
+	4,  // 21: market.v1.StreamAccountUpdatesResponse.positions:type_name -> market.v1.Position
+	31, // 22: market.v1.StreamAccountUpdatesResponse.trade:type_name -> market.v1.Trade
+	6,  // 23: market.v1.AccountService.CreateAccount:input_type -> market.v1.CreateAccountRequest
+	8,  // 24: market.v1.AccountService.FundAccount:input_type -> market.v1.FundAccountRequest
+	10, // 25: market.v1.AccountService.WithdrawFunds:input_type -> market.v1.WithdrawFundsRequest
+	12, // 26: market.v1.AccountService.GetAccount:input_type -> market.v1.GetAccountRequest
+	14, // 27: market.v1.AccountService.ListPositions:input_type -> market.v1.ListPositionsRequest
+	22, // 28: market.v1.AccountService.StreamAccountUpdates:input_type -> market.v1.StreamAccountUpdatesRequest
+	24, // 29: market.v1.AccountService.GetMarginSettings:input_type -> market.v1.GetMarginSettingsRequest
+	16, // 30: market.v1.AccountService.ListOrders:input_type -> market.v1.ListOrdersRequest
+	19, // 31: market.v1.AccountService.ListMyTrades:input_type -> market.v1.ListMyTradesRequest
+	7,  // 32: market.v1.AccountService.CreateAccount:output_type -> market.v1.CreateAccountResponse
+	9,  // 33: market.v1.AccountService.FundAccount:output_type -> market.v1.FundAccountResponse
+	11, // 34: market.v1.AccountService.WithdrawFunds:output_type -> market.v1.WithdrawFundsResponse
+	13, // 35: market.v1.AccountService.GetAccount:output_type -> market.v1.GetAccountResponse
+	15, // 36: market.v1.AccountService.ListPositions:output_type -> market.v1.ListPositionsResponse
+	23, // 37: market.v1.AccountService.StreamAccountUpdates:output_type -> market.v1.StreamAccountUpdatesResponse
+	25, // 38: market.v1.AccountService.GetMarginSettings:output_type -> market.v1.GetMarginSettingsResponse
+	18, // 39: market.v1.AccountService.ListOrders:output_type -> market.v1.ListOrdersResponse
+	21, // 40: market.v1.AccountService.ListMyTrades:output_type -> market.v1.ListMyTradesResponse
+	32, // [32:41] is the sub-list for method output_type
+	23, // [23:32] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_market_v1_account_proto_init() }
+func file_market_v1_account_proto_init() {
+	if File_market_v1_account_proto != nil {
+		return
+	}
+	file_market_v1_market_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_market_v1_account_proto_rawDesc), len(file_market_v1_account_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_market_v1_account_proto_goTypes,
+		DependencyIndexes: file_market_v1_account_proto_depIdxs,
+		EnumInfos:         file_market_v1_account_proto_enumTypes,
+		MessageInfos:      file_market_v1_account_proto_msgTypes,
+	}.Build()
+	File_market_v1_account_proto = out.File
+	file_market_v1_account_proto_goTypes = nil
+	file_market_v1_account_proto_depIdxs = nil
+}
@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: market/v1/admin.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReplayAction int32
+
+const (
+	ReplayAction_REPLAY_ACTION_UNSPECIFIED ReplayAction = 0
+	// Reports the replay position without changing it.
+	ReplayAction_REPLAY_ACTION_STATUS ReplayAction = 1
+	ReplayAction_REPLAY_ACTION_PLAY   ReplayAction = 2
+	ReplayAction_REPLAY_ACTION_PAUSE  ReplayAction = 3
+	// Pauses and publishes the next steps entries.
+	ReplayAction_REPLAY_ACTION_STEP ReplayAction = 4
+	// Moves to the first entry at or after seek_time_ms.
+	ReplayAction_REPLAY_ACTION_SEEK      ReplayAction = 5
+	ReplayAction_REPLAY_ACTION_SET_SPEED ReplayAction = 6
+)
+
+// Enum value maps for ReplayAction.
+var (
+	ReplayAction_name = map[int32]string{
+		0: "REPLAY_ACTION_UNSPECIFIED",
+		1: "REPLAY_ACTION_STATUS",
+		2: "REPLAY_ACTION_PLAY",
+		3: "REPLAY_ACTION_PAUSE",
+		4: "REPLAY_ACTION_STEP",
+		5: "REPLAY_ACTION_SEEK",
+		6: "REPLAY_ACTION_SET_SPEED",
+	}
+	ReplayAction_value = map[string]int32{
+		"REPLAY_ACTION_UNSPECIFIED": 0,
+		"REPLAY_ACTION_STATUS":      1,
+		"REPLAY_ACTION_PLAY":        2,
+		"REPLAY_ACTION_PAUSE":       3,
+		"REPLAY_ACTION_STEP":        4,
+		"REPLAY_ACTION_SEEK":        5,
+		"REPLAY_ACTION_SET_SPEED":   6,
+	}
+)
+
+func (x ReplayAction) Enum() *ReplayAction {
+	p := new(ReplayAction)
+	*p = x
+	return p
+}
+
+func (x ReplayAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplayAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (ReplayAction) Type() protoreflect.EnumType {
+	return &file_market_v1_admin_proto_enumTypes[0]
+}
+
+func (x ReplayAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplayAction.Descriptor instead.
+func (ReplayAction) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type HaltSymbolRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Halt length in engine time; zero halts until ResumeSymbol is called.
+	DurationMs    int64 `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HaltSymbolRequest) Reset() {
+	*x = HaltSymbolRequest{}
+	mi := &file_market_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HaltSymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltSymbolRequest) ProtoMessage() {}
+
+func (x *HaltSymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltSymbolRequest.ProtoReflect.Descriptor instead.
+func (*HaltSymbolRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HaltSymbolRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *HaltSymbolRequest) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type HaltSymbolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HaltSymbolResponse) Reset() {
+	*x = HaltSymbolResponse{}
+	mi := &file_market_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HaltSymbolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltSymbolResponse) ProtoMessage() {}
+
+func (x *HaltSymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltSymbolResponse.ProtoReflect.Descriptor instead.
+func (*HaltSymbolResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type ResumeSymbolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSymbolRequest) Reset() {
+	*x = ResumeSymbolRequest{}
+	mi := &file_market_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSymbolRequest) ProtoMessage() {}
+
+func (x *ResumeSymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSymbolRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSymbolRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResumeSymbolRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type ResumeSymbolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSymbolResponse) Reset() {
+	*x = ResumeSymbolResponse{}
+	mi := &file_market_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSymbolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSymbolResponse) ProtoMessage() {}
+
+func (x *ResumeSymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSymbolResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSymbolResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type RunScenarioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scenario definition in YAML or JSON.
+	Definition    string `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunScenarioRequest) Reset() {
+	*x = RunScenarioRequest{}
+	mi := &file_market_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunScenarioRequest) ProtoMessage() {}
+
+func (x *RunScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunScenarioRequest.ProtoReflect.Descriptor instead.
+func (*RunScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RunScenarioRequest) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+type RunScenarioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	EventCount    int32                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunScenarioResponse) Reset() {
+	*x = RunScenarioResponse{}
+	mi := &file_market_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunScenarioResponse) ProtoMessage() {}
+
+func (x *RunScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunScenarioResponse.ProtoReflect.Descriptor instead.
+func (*RunScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RunScenarioResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunScenarioResponse) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+type StopScenarioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopScenarioRequest) Reset() {
+	*x = StopScenarioRequest{}
+	mi := &file_market_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopScenarioRequest) ProtoMessage() {}
+
+func (x *StopScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopScenarioRequest.ProtoReflect.Descriptor instead.
+func (*StopScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+type StopScenarioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stopped       bool                   `protobuf:"varint,1,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopScenarioResponse) Reset() {
+	*x = StopScenarioResponse{}
+	mi := &file_market_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopScenarioResponse) ProtoMessage() {}
+
+func (x *StopScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopScenarioResponse.ProtoReflect.Descriptor instead.
+func (*StopScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StopScenarioResponse) GetStopped() bool {
+	if x != nil {
+		return x.Stopped
+	}
+	return false
+}
+
+type ControlReplayRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Action ReplayAction           `protobuf:"varint,1,opt,name=action,proto3,enum=market.v1.ReplayAction" json:"action,omitempty"`
+	// Playback multiple for REPLAY_ACTION_SET_SPEED, e.g. 1 or 10.
+	Speed float64 `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Entries to publish for REPLAY_ACTION_STEP; defaults to 1.
+	Steps int32 `protobuf:"varint,3,opt,name=steps,proto3" json:"steps,omitempty"`
+	// Tape time in Unix milliseconds for REPLAY_ACTION_SEEK.
+	SeekTimeMs    int64 `protobuf:"varint,4,opt,name=seek_time_ms,json=seekTimeMs,proto3" json:"seek_time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlReplayRequest) Reset() {
+	*x = ControlReplayRequest{}
+	mi := &file_market_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlReplayRequest) ProtoMessage() {}
+
+func (x *ControlReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlReplayRequest.ProtoReflect.Descriptor instead.
+func (*ControlReplayRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ControlReplayRequest) GetAction() ReplayAction {
+	if x != nil {
+		return x.Action
+	}
+	return ReplayAction_REPLAY_ACTION_UNSPECIFIED
+}
+
+func (x *ControlReplayRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *ControlReplayRequest) GetSteps() int32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *ControlReplayRequest) GetSeekTimeMs() int64 {
+	if x != nil {
+		return x.SeekTimeMs
+	}
+	return 0
+}
+
+type ControlReplayResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Position int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Length   int32                  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Playing  bool                   `protobuf:"varint,3,opt,name=playing,proto3" json:"playing,omitempty"`
+	Speed    float64                `protobuf:"fixed64,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Current tape time in Unix milliseconds.
+	TimeMs        int64 `protobuf:"varint,5,opt,name=time_ms,json=timeMs,proto3" json:"time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlReplayResponse) Reset() {
+	*x = ControlReplayResponse{}
+	mi := &file_market_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlReplayResponse) ProtoMessage() {}
+
+func (x *ControlReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlReplayResponse.ProtoReflect.Descriptor instead.
+func (*ControlReplayResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ControlReplayResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ControlReplayResponse) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ControlReplayResponse) GetPlaying() bool {
+	if x != nil {
+		return x.Playing
+	}
+	return false
+}
+
+func (x *ControlReplayResponse) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *ControlReplayResponse) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
+type ReloadReferenceDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Snapshot day as YYYY-MM-DD; empty loads the latest snapshot.
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadReferenceDataRequest) Reset() {
+	*x = ReloadReferenceDataRequest{}
+	mi := &file_market_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadReferenceDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadReferenceDataRequest) ProtoMessage() {}
+
+func (x *ReloadReferenceDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadReferenceDataRequest.ProtoReflect.Descriptor instead.
+func (*ReloadReferenceDataRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReloadReferenceDataRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ReloadReferenceDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Day of the snapshot that was loaded, as YYYY-MM-DD.
+	Date        string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	SymbolCount int32  `protobuf:"varint,2,opt,name=symbol_count,json=symbolCount,proto3" json:"symbol_count,omitempty"`
+	// Symbols the snapshot listed that were not listed before.
+	Listed []string `protobuf:"bytes,3,rep,name=listed,proto3" json:"listed,omitempty"`
+	// Symbols missing from the snapshot; their resting orders were cancelled.
+	Delisted      []string `protobuf:"bytes,4,rep,name=delisted,proto3" json:"delisted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadReferenceDataResponse) Reset() {
+	*x = ReloadReferenceDataResponse{}
+	mi := &file_market_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadReferenceDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadReferenceDataResponse) ProtoMessage() {}
+
+func (x *ReloadReferenceDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadReferenceDataResponse.ProtoReflect.Descriptor instead.
+func (*ReloadReferenceDataResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReloadReferenceDataResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ReloadReferenceDataResponse) GetSymbolCount() int32 {
+	if x != nil {
+		return x.SymbolCount
+	}
+	return 0
+}
+
+func (x *ReloadReferenceDataResponse) GetListed() []string {
+	if x != nil {
+		return x.Listed
+	}
+	return nil
+}
+
+func (x *ReloadReferenceDataResponse) GetDelisted() []string {
+	if x != nil {
+		return x.Delisted
+	}
+	return nil
+}
+
+var File_market_v1_admin_proto protoreflect.FileDescriptor
+
+const file_market_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x15market/v1/admin.proto\x12\tmarket.v1\"L\n" +
+	"\x11HaltSymbolRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1f\n" +
+	"\vduration_ms\x18\x02 \x01(\x03R\n" +
+	"durationMs\"\x14\n" +
+	"\x12HaltSymbolResponse\"-\n" +
+	"\x13ResumeSymbolRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"\x16\n" +
+	"\x14ResumeSymbolResponse\"4\n" +
+	"\x12RunScenarioRequest\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\tR\n" +
+	"definition\"J\n" +
+	"\x13RunScenarioResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x05R\n" +
+	"eventCount\"\x15\n" +
+	"\x13StopScenarioRequest\"0\n" +
+	"\x14StopScenarioResponse\x12\x18\n" +
+	"\astopped\x18\x01 \x01(\bR\astopped\"\x95\x01\n" +
+	"\x14ControlReplayRequest\x12/\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x17.market.v1.ReplayActionR\x06action\x12\x14\n" +
+	"\x05speed\x18\x02 \x01(\x01R\x05speed\x12\x14\n" +
+	"\x05steps\x18\x03 \x01(\x05R\x05steps\x12 \n" +
+	"\fseek_time_ms\x18\x04 \x01(\x03R\n" +
+	"seekTimeMs\"\x94\x01\n" +
+	"\x15ControlReplayResponse\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12\x18\n" +
+	"\aplaying\x18\x03 \x01(\bR\aplaying\x12\x14\n" +
+	"\x05speed\x18\x04 \x01(\x01R\x05speed\x12\x17\n" +
+	"\atime_ms\x18\x05 \x01(\x03R\x06timeMs\"0\n" +
+	"\x1aReloadReferenceDataRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\x88\x01\n" +
+	"\x1bReloadReferenceDataResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12!\n" +
+	"\fsymbol_count\x18\x02 \x01(\x05R\vsymbolCount\x12\x16\n" +
+	"\x06listed\x18\x03 \x03(\tR\x06listed\x12\x1a\n" +
+	"\bdelisted\x18\x04 \x03(\tR\bdelisted*\xc5\x01\n" +
+	"\fReplayAction\x12\x1d\n" +
+	"\x19REPLAY_ACTION_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14REPLAY_ACTION_STATUS\x10\x01\x12\x16\n" +
+	"\x12REPLAY_ACTION_PLAY\x10\x02\x12\x17\n" +
+	"\x13REPLAY_ACTION_PAUSE\x10\x03\x12\x16\n" +
+	"\x12REPLAY_ACTION_STEP\x10\x04\x12\x16\n" +
+	"\x12REPLAY_ACTION_SEEK\x10\x05\x12\x1b\n" +
+	"\x17REPLAY_ACTION_SET_SPEED\x10\x062\x8f\x04\n" +
+	"\fAdminService\x12K\n" +
+	"\n" +
+	"HaltSymbol\x12\x1c.market.v1.HaltSymbolRequest\x1a\x1d.market.v1.HaltSymbolResponse\"\x00\x12Q\n" +
+	"\fResumeSymbol\x12\x1e.market.v1.ResumeSymbolRequest\x1a\x1f.market.v1.ResumeSymbolResponse\"\x00\x12N\n" +
+	"\vRunScenario\x12\x1d.market.v1.RunScenarioRequest\x1a\x1e.market.v1.RunScenarioResponse\"\x00\x12Q\n" +
+	"\fStopScenario\x12\x1e.market.v1.StopScenarioRequest\x1a\x1f.market.v1.StopScenarioResponse\"\x00\x12T\n" +
+	"\rControlReplay\x12\x1f.market.v1.ControlReplayRequest\x1a .market.v1.ControlReplayResponse\"\x00\x12f\n" +
+	"\x13ReloadReferenceData\x12%.market.v1.ReloadReferenceDataRequest\x1a&.market.v1.ReloadReferenceDataResponse\"\x00B#Z!market-engine-go/gen/go/market/v1b\x06proto3"
+
+var (
+	file_market_v1_admin_proto_rawDescOnce sync.Once
+	file_market_v1_admin_proto_rawDescData []byte
+)
+
+func file_market_v1_admin_proto_rawDescGZIP() []byte {
+	file_market_v1_admin_proto_rawDescOnce.Do(func() {
+		file_market_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_market_v1_admin_proto_rawDesc), len(file_market_v1_admin_proto_rawDesc)))
+	})
+	return file_market_v1_admin_proto_rawDescData
+}
+
+var file_market_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_market_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_market_v1_admin_proto_goTypes = []any{
+	(ReplayAction)(0),                   // 0: market.v1.ReplayAction
+	(*HaltSymbolRequest)(nil),           // 1: market.v1.HaltSymbolRequest
+	(*HaltSymbolResponse)(nil),          // 2: market.v1.HaltSymbolResponse
+	(*ResumeSymbolRequest)(nil),         // 3: market.v1.ResumeSymbolRequest
+	(*ResumeSymbolResponse)(nil),        // 4: market.v1.ResumeSymbolResponse
+	(*RunScenarioRequest)(nil),          // 5: market.v1.RunScenarioRequest
+	(*RunScenarioResponse)(nil),         // 6: market.v1.RunScenarioResponse
+	(*StopScenarioRequest)(nil),         // 7: market.v1.StopScenarioRequest
+	(*StopScenarioResponse)(nil),        // 8: market.v1.StopScenarioResponse
+	(*ControlReplayRequest)(nil),        // 9: market.v1.ControlReplayRequest
+	(*ControlReplayResponse)(nil),       // 10: market.v1.ControlReplayResponse
+	(*ReloadReferenceDataRequest)(nil),  // 11: market.v1.ReloadReferenceDataRequest
+	(*ReloadReferenceDataResponse)(nil), // 12: market.v1.ReloadReferenceDataResponse
+}
+var file_market_v1_admin_proto_depIdxs = []int32{
+	0,  // 0: market.v1.ControlReplayRequest.action:type_name -> market.v1.ReplayAction
+	1,  // 1: market.v1.AdminService.HaltSymbol:input_type -> market.v1.HaltSymbolRequest
+	3,  // 2: market.v1.AdminService.ResumeSymbol:input_type -> market.v1.ResumeSymbolRequest
+	5,  // 3: market.v1.AdminService.RunScenario:input_type -> market.v1.RunScenarioRequest
+	7,  // 4: market.v1.AdminService.StopScenario:input_type -> market.v1.StopScenarioRequest
+	9,  // 5: market.v1.AdminService.ControlReplay:input_type -> market.v1.ControlReplayRequest
+	11, // 6: market.v1.AdminService.ReloadReferenceData:input_type -> market.v1.ReloadReferenceDataRequest
+	2,  // 7: market.v1.AdminService.HaltSymbol:output_type -> market.v1.HaltSymbolResponse
+	4,  // 8: market.v1.AdminService.ResumeSymbol:output_type -> market.v1.ResumeSymbolResponse
+	6,  // 9: market.v1.AdminService.RunScenario:output_type -> market.v1.RunScenarioResponse
+	8,  // 10: market.v1.AdminService.StopScenario:output_type -> market.v1.StopScenarioResponse
+	10, // 11: market.v1.AdminService.ControlReplay:output_type -> market.v1.ControlReplayResponse
+	12, // 12: market.v1.AdminService.ReloadReferenceData:output_type -> market.v1.ReloadReferenceDataResponse
+	7,  // [7:13] is the sub-list for method output_type
+	1,  // [1:7] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_market_v1_admin_proto_init() }
+func file_market_v1_admin_proto_init() {
+	if File_market_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_market_v1_admin_proto_rawDesc), len(file_market_v1_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_market_v1_admin_proto_goTypes,
+		DependencyIndexes: file_market_v1_admin_proto_depIdxs,
+		EnumInfos:         file_market_v1_admin_proto_enumTypes,
+		MessageInfos:      file_market_v1_admin_proto_msgTypes,
+	}.Build()
+	File_market_v1_admin_proto = out.File
+	file_market_v1_admin_proto_goTypes = nil
+	file_market_v1_admin_proto_depIdxs = nil
+}
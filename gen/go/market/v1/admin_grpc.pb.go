@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: market/v1/admin.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_HaltSymbol_FullMethodName          = "/market.v1.AdminService/HaltSymbol"
+	AdminService_ResumeSymbol_FullMethodName        = "/market.v1.AdminService/ResumeSymbol"
+	AdminService_RunScenario_FullMethodName         = "/market.v1.AdminService/RunScenario"
+	AdminService_StopScenario_FullMethodName        = "/market.v1.AdminService/StopScenario"
+	AdminService_ControlReplay_FullMethodName       = "/market.v1.AdminService/ControlReplay"
+	AdminService_ReloadReferenceData_FullMethodName = "/market.v1.AdminService/ReloadReferenceData"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	HaltSymbol(ctx context.Context, in *HaltSymbolRequest, opts ...grpc.CallOption) (*HaltSymbolResponse, error)
+	ResumeSymbol(ctx context.Context, in *ResumeSymbolRequest, opts ...grpc.CallOption) (*ResumeSymbolResponse, error)
+	RunScenario(ctx context.Context, in *RunScenarioRequest, opts ...grpc.CallOption) (*RunScenarioResponse, error)
+	StopScenario(ctx context.Context, in *StopScenarioRequest, opts ...grpc.CallOption) (*StopScenarioResponse, error)
+	ControlReplay(ctx context.Context, in *ControlReplayRequest, opts ...grpc.CallOption) (*ControlReplayResponse, error)
+	// Starts a new simulated day from a daily snapshot's reference prices.
+	ReloadReferenceData(ctx context.Context, in *ReloadReferenceDataRequest, opts ...grpc.CallOption) (*ReloadReferenceDataResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) HaltSymbol(ctx context.Context, in *HaltSymbolRequest, opts ...grpc.CallOption) (*HaltSymbolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HaltSymbolResponse)
+	err := c.cc.Invoke(ctx, AdminService_HaltSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeSymbol(ctx context.Context, in *ResumeSymbolRequest, opts ...grpc.CallOption) (*ResumeSymbolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeSymbolResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RunScenario(ctx context.Context, in *RunScenarioRequest, opts ...grpc.CallOption) (*RunScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunScenarioResponse)
+	err := c.cc.Invoke(ctx, AdminService_RunScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StopScenario(ctx context.Context, in *StopScenarioRequest, opts ...grpc.CallOption) (*StopScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopScenarioResponse)
+	err := c.cc.Invoke(ctx, AdminService_StopScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ControlReplay(ctx context.Context, in *ControlReplayRequest, opts ...grpc.CallOption) (*ControlReplayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlReplayResponse)
+	err := c.cc.Invoke(ctx, AdminService_ControlReplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReloadReferenceData(ctx context.Context, in *ReloadReferenceDataRequest, opts ...grpc.CallOption) (*ReloadReferenceDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadReferenceDataResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReloadReferenceData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	HaltSymbol(context.Context, *HaltSymbolRequest) (*HaltSymbolResponse, error)
+	ResumeSymbol(context.Context, *ResumeSymbolRequest) (*ResumeSymbolResponse, error)
+	RunScenario(context.Context, *RunScenarioRequest) (*RunScenarioResponse, error)
+	StopScenario(context.Context, *StopScenarioRequest) (*StopScenarioResponse, error)
+	ControlReplay(context.Context, *ControlReplayRequest) (*ControlReplayResponse, error)
+	// Starts a new simulated day from a daily snapshot's reference prices.
+	ReloadReferenceData(context.Context, *ReloadReferenceDataRequest) (*ReloadReferenceDataResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) HaltSymbol(context.Context, *HaltSymbolRequest) (*HaltSymbolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HaltSymbol not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeSymbol(context.Context, *ResumeSymbolRequest) (*ResumeSymbolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeSymbol not implemented")
+}
+func (UnimplementedAdminServiceServer) RunScenario(context.Context, *RunScenarioRequest) (*RunScenarioResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunScenario not implemented")
+}
+func (UnimplementedAdminServiceServer) StopScenario(context.Context, *StopScenarioRequest) (*StopScenarioResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopScenario not implemented")
+}
+func (UnimplementedAdminServiceServer) ControlReplay(context.Context, *ControlReplayRequest) (*ControlReplayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ControlReplay not implemented")
+}
+func (UnimplementedAdminServiceServer) ReloadReferenceData(context.Context, *ReloadReferenceDataRequest) (*ReloadReferenceDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadReferenceData not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_HaltSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HaltSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).HaltSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_HaltSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).HaltSymbol(ctx, req.(*HaltSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeSymbol(ctx, req.(*ResumeSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RunScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RunScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RunScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RunScenario(ctx, req.(*RunScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StopScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StopScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StopScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StopScenario(ctx, req.(*StopScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ControlReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ControlReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ControlReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ControlReplay(ctx, req.(*ControlReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReloadReferenceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadReferenceDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReloadReferenceData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReloadReferenceData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReloadReferenceData(ctx, req.(*ReloadReferenceDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "market.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HaltSymbol",
+			Handler:    _AdminService_HaltSymbol_Handler,
+		},
+		{
+			MethodName: "ResumeSymbol",
+			Handler:    _AdminService_ResumeSymbol_Handler,
+		},
+		{
+			MethodName: "RunScenario",
+			Handler:    _AdminService_RunScenario_Handler,
+		},
+		{
+			MethodName: "StopScenario",
+			Handler:    _AdminService_StopScenario_Handler,
+		},
+		{
+			MethodName: "ControlReplay",
+			Handler:    _AdminService_ControlReplay_Handler,
+		},
+		{
+			MethodName: "ReloadReferenceData",
+			Handler:    _AdminService_ReloadReferenceData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "market/v1/admin.proto",
+}
@@ -22,6 +22,476 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TickerStatus int32
+
+const (
+	TickerStatus_TICKER_STATUS_UNSPECIFIED TickerStatus = 0
+	TickerStatus_TICKER_STATUS_LISTED      TickerStatus = 1
+	TickerStatus_TICKER_STATUS_DELISTED    TickerStatus = 2
+)
+
+// Enum value maps for TickerStatus.
+var (
+	TickerStatus_name = map[int32]string{
+		0: "TICKER_STATUS_UNSPECIFIED",
+		1: "TICKER_STATUS_LISTED",
+		2: "TICKER_STATUS_DELISTED",
+	}
+	TickerStatus_value = map[string]int32{
+		"TICKER_STATUS_UNSPECIFIED": 0,
+		"TICKER_STATUS_LISTED":      1,
+		"TICKER_STATUS_DELISTED":    2,
+	}
+)
+
+func (x TickerStatus) Enum() *TickerStatus {
+	p := new(TickerStatus)
+	*p = x
+	return p
+}
+
+func (x TickerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TickerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[0].Descriptor()
+}
+
+func (TickerStatus) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[0]
+}
+
+func (x TickerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TickerStatus.Descriptor instead.
+func (TickerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{0}
+}
+
+type OrderSide int32
+
+const (
+	OrderSide_ORDER_SIDE_UNSPECIFIED OrderSide = 0
+	OrderSide_ORDER_SIDE_BUY         OrderSide = 1
+	OrderSide_ORDER_SIDE_SELL        OrderSide = 2
+)
+
+// Enum value maps for OrderSide.
+var (
+	OrderSide_name = map[int32]string{
+		0: "ORDER_SIDE_UNSPECIFIED",
+		1: "ORDER_SIDE_BUY",
+		2: "ORDER_SIDE_SELL",
+	}
+	OrderSide_value = map[string]int32{
+		"ORDER_SIDE_UNSPECIFIED": 0,
+		"ORDER_SIDE_BUY":         1,
+		"ORDER_SIDE_SELL":        2,
+	}
+)
+
+func (x OrderSide) Enum() *OrderSide {
+	p := new(OrderSide)
+	*p = x
+	return p
+}
+
+func (x OrderSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[1].Descriptor()
+}
+
+func (OrderSide) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[1]
+}
+
+func (x OrderSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSide.Descriptor instead.
+func (OrderSide) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{1}
+}
+
+type OrderType int32
+
+const (
+	OrderType_ORDER_TYPE_UNSPECIFIED OrderType = 0
+	OrderType_ORDER_TYPE_LIMIT       OrderType = 1
+	OrderType_ORDER_TYPE_MARKET      OrderType = 2
+)
+
+// Enum value maps for OrderType.
+var (
+	OrderType_name = map[int32]string{
+		0: "ORDER_TYPE_UNSPECIFIED",
+		1: "ORDER_TYPE_LIMIT",
+		2: "ORDER_TYPE_MARKET",
+	}
+	OrderType_value = map[string]int32{
+		"ORDER_TYPE_UNSPECIFIED": 0,
+		"ORDER_TYPE_LIMIT":       1,
+		"ORDER_TYPE_MARKET":      2,
+	}
+)
+
+func (x OrderType) Enum() *OrderType {
+	p := new(OrderType)
+	*p = x
+	return p
+}
+
+func (x OrderType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderType) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[2].Descriptor()
+}
+
+func (OrderType) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[2]
+}
+
+func (x OrderType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderType.Descriptor instead.
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{2}
+}
+
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED      OrderStatus = 0
+	OrderStatus_ORDER_STATUS_NEW              OrderStatus = 1
+	OrderStatus_ORDER_STATUS_PARTIALLY_FILLED OrderStatus = 2
+	OrderStatus_ORDER_STATUS_FILLED           OrderStatus = 3
+	OrderStatus_ORDER_STATUS_CANCELLED        OrderStatus = 4
+	// A day order still resting at the close of its session.
+	OrderStatus_ORDER_STATUS_EXPIRED OrderStatus = 5
+	// Only on execution reports of orders that never reached the book.
+	OrderStatus_ORDER_STATUS_REJECTED OrderStatus = 6
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_NEW",
+		2: "ORDER_STATUS_PARTIALLY_FILLED",
+		3: "ORDER_STATUS_FILLED",
+		4: "ORDER_STATUS_CANCELLED",
+		5: "ORDER_STATUS_EXPIRED",
+		6: "ORDER_STATUS_REJECTED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED":      0,
+		"ORDER_STATUS_NEW":              1,
+		"ORDER_STATUS_PARTIALLY_FILLED": 2,
+		"ORDER_STATUS_FILLED":           3,
+		"ORDER_STATUS_CANCELLED":        4,
+		"ORDER_STATUS_EXPIRED":          5,
+		"ORDER_STATUS_REJECTED":         6,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[3].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[3]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{3}
+}
+
+type TimeInForce int32
+
+const (
+	// Good till cancelled.
+	TimeInForce_TIME_IN_FORCE_UNSPECIFIED TimeInForce = 0
+	TimeInForce_TIME_IN_FORCE_GTC         TimeInForce = 1
+	// Expires at the close of the session it is placed for: that day's on a
+	// trading day before the close, otherwise the next trading day's.
+	TimeInForce_TIME_IN_FORCE_DAY TimeInForce = 2
+)
+
+// Enum value maps for TimeInForce.
+var (
+	TimeInForce_name = map[int32]string{
+		0: "TIME_IN_FORCE_UNSPECIFIED",
+		1: "TIME_IN_FORCE_GTC",
+		2: "TIME_IN_FORCE_DAY",
+	}
+	TimeInForce_value = map[string]int32{
+		"TIME_IN_FORCE_UNSPECIFIED": 0,
+		"TIME_IN_FORCE_GTC":         1,
+		"TIME_IN_FORCE_DAY":         2,
+	}
+)
+
+func (x TimeInForce) Enum() *TimeInForce {
+	p := new(TimeInForce)
+	*p = x
+	return p
+}
+
+func (x TimeInForce) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeInForce) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[4].Descriptor()
+}
+
+func (TimeInForce) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[4]
+}
+
+func (x TimeInForce) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeInForce.Descriptor instead.
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{4}
+}
+
+// Why an order was rejected before reaching the book. A rejected PlaceOrder
+// carries an google.rpc.ErrorInfo detail in domain market.v1 whose reason
+// is the value name without the ORDER_REJECT_REASON_ prefix, such as
+// MAX_ORDER_VALUE. RATE_LIMIT rejections use RESOURCE_EXHAUSTED and the
+// rest FAILED_PRECONDITION.
+type OrderRejectReason int32
+
+const (
+	OrderRejectReason_ORDER_REJECT_REASON_UNSPECIFIED               OrderRejectReason = 0
+	OrderRejectReason_ORDER_REJECT_REASON_INSUFFICIENT_BUYING_POWER OrderRejectReason = 1
+	OrderRejectReason_ORDER_REJECT_REASON_INSUFFICIENT_SHARES       OrderRejectReason = 2
+	OrderRejectReason_ORDER_REJECT_REASON_MAX_ORDER_VALUE           OrderRejectReason = 3
+	OrderRejectReason_ORDER_REJECT_REASON_MAX_ORDER_QUANTITY        OrderRejectReason = 4
+	// The limit price, or a market order's furthest fill, is too far from
+	// the last price.
+	OrderRejectReason_ORDER_REJECT_REASON_PRICE_BAND   OrderRejectReason = 5
+	OrderRejectReason_ORDER_REJECT_REASON_MAX_POSITION OrderRejectReason = 6
+	// The account has reached its daily loss limit; only orders that close
+	// positions are accepted.
+	OrderRejectReason_ORDER_REJECT_REASON_DAILY_LOSS OrderRejectReason = 7
+	// The order would trade against a resting order of the same account.
+	OrderRejectReason_ORDER_REJECT_REASON_SELF_TRADE    OrderRejectReason = 8
+	OrderRejectReason_ORDER_REJECT_REASON_RATE_LIMIT    OrderRejectReason = 9
+	OrderRejectReason_ORDER_REJECT_REASON_SYMBOL_HALTED OrderRejectReason = 10
+	// The account is in a margin call; only orders that reduce positions are
+	// accepted.
+	OrderRejectReason_ORDER_REJECT_REASON_MARGIN_CALL OrderRejectReason = 11
+)
+
+// Enum value maps for OrderRejectReason.
+var (
+	OrderRejectReason_name = map[int32]string{
+		0:  "ORDER_REJECT_REASON_UNSPECIFIED",
+		1:  "ORDER_REJECT_REASON_INSUFFICIENT_BUYING_POWER",
+		2:  "ORDER_REJECT_REASON_INSUFFICIENT_SHARES",
+		3:  "ORDER_REJECT_REASON_MAX_ORDER_VALUE",
+		4:  "ORDER_REJECT_REASON_MAX_ORDER_QUANTITY",
+		5:  "ORDER_REJECT_REASON_PRICE_BAND",
+		6:  "ORDER_REJECT_REASON_MAX_POSITION",
+		7:  "ORDER_REJECT_REASON_DAILY_LOSS",
+		8:  "ORDER_REJECT_REASON_SELF_TRADE",
+		9:  "ORDER_REJECT_REASON_RATE_LIMIT",
+		10: "ORDER_REJECT_REASON_SYMBOL_HALTED",
+		11: "ORDER_REJECT_REASON_MARGIN_CALL",
+	}
+	OrderRejectReason_value = map[string]int32{
+		"ORDER_REJECT_REASON_UNSPECIFIED":               0,
+		"ORDER_REJECT_REASON_INSUFFICIENT_BUYING_POWER": 1,
+		"ORDER_REJECT_REASON_INSUFFICIENT_SHARES":       2,
+		"ORDER_REJECT_REASON_MAX_ORDER_VALUE":           3,
+		"ORDER_REJECT_REASON_MAX_ORDER_QUANTITY":        4,
+		"ORDER_REJECT_REASON_PRICE_BAND":                5,
+		"ORDER_REJECT_REASON_MAX_POSITION":              6,
+		"ORDER_REJECT_REASON_DAILY_LOSS":                7,
+		"ORDER_REJECT_REASON_SELF_TRADE":                8,
+		"ORDER_REJECT_REASON_RATE_LIMIT":                9,
+		"ORDER_REJECT_REASON_SYMBOL_HALTED":             10,
+		"ORDER_REJECT_REASON_MARGIN_CALL":               11,
+	}
+)
+
+func (x OrderRejectReason) Enum() *OrderRejectReason {
+	p := new(OrderRejectReason)
+	*p = x
+	return p
+}
+
+func (x OrderRejectReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderRejectReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[5].Descriptor()
+}
+
+func (OrderRejectReason) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[5]
+}
+
+func (x OrderRejectReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderRejectReason.Descriptor instead.
+func (OrderRejectReason) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{5}
+}
+
+type CorporateActionType int32
+
+const (
+	CorporateActionType_CORPORATE_ACTION_TYPE_UNSPECIFIED   CorporateActionType = 0
+	CorporateActionType_CORPORATE_ACTION_TYPE_DIVIDEND      CorporateActionType = 1
+	CorporateActionType_CORPORATE_ACTION_TYPE_SPLIT         CorporateActionType = 2
+	CorporateActionType_CORPORATE_ACTION_TYPE_REVERSE_SPLIT CorporateActionType = 3
+	CorporateActionType_CORPORATE_ACTION_TYPE_RIGHTS_ISSUE  CorporateActionType = 4
+)
+
+// Enum value maps for CorporateActionType.
+var (
+	CorporateActionType_name = map[int32]string{
+		0: "CORPORATE_ACTION_TYPE_UNSPECIFIED",
+		1: "CORPORATE_ACTION_TYPE_DIVIDEND",
+		2: "CORPORATE_ACTION_TYPE_SPLIT",
+		3: "CORPORATE_ACTION_TYPE_REVERSE_SPLIT",
+		4: "CORPORATE_ACTION_TYPE_RIGHTS_ISSUE",
+	}
+	CorporateActionType_value = map[string]int32{
+		"CORPORATE_ACTION_TYPE_UNSPECIFIED":   0,
+		"CORPORATE_ACTION_TYPE_DIVIDEND":      1,
+		"CORPORATE_ACTION_TYPE_SPLIT":         2,
+		"CORPORATE_ACTION_TYPE_REVERSE_SPLIT": 3,
+		"CORPORATE_ACTION_TYPE_RIGHTS_ISSUE":  4,
+	}
+)
+
+func (x CorporateActionType) Enum() *CorporateActionType {
+	p := new(CorporateActionType)
+	*p = x
+	return p
+}
+
+func (x CorporateActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CorporateActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[6].Descriptor()
+}
+
+func (CorporateActionType) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[6]
+}
+
+func (x CorporateActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CorporateActionType.Descriptor instead.
+func (CorporateActionType) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{6}
+}
+
+type ExecutionType int32
+
+const (
+	ExecutionType_EXECUTION_TYPE_UNSPECIFIED  ExecutionType = 0
+	ExecutionType_EXECUTION_TYPE_NEW          ExecutionType = 1
+	ExecutionType_EXECUTION_TYPE_PARTIAL_FILL ExecutionType = 2
+	ExecutionType_EXECUTION_TYPE_FILL         ExecutionType = 3
+	ExecutionType_EXECUTION_TYPE_CANCELLED    ExecutionType = 4
+	// A corporate action adjusted the resting order's price and quantity.
+	ExecutionType_EXECUTION_TYPE_REPLACED ExecutionType = 5
+	ExecutionType_EXECUTION_TYPE_REJECTED ExecutionType = 6
+	ExecutionType_EXECUTION_TYPE_EXPIRED  ExecutionType = 7
+)
+
+// Enum value maps for ExecutionType.
+var (
+	ExecutionType_name = map[int32]string{
+		0: "EXECUTION_TYPE_UNSPECIFIED",
+		1: "EXECUTION_TYPE_NEW",
+		2: "EXECUTION_TYPE_PARTIAL_FILL",
+		3: "EXECUTION_TYPE_FILL",
+		4: "EXECUTION_TYPE_CANCELLED",
+		5: "EXECUTION_TYPE_REPLACED",
+		6: "EXECUTION_TYPE_REJECTED",
+		7: "EXECUTION_TYPE_EXPIRED",
+	}
+	ExecutionType_value = map[string]int32{
+		"EXECUTION_TYPE_UNSPECIFIED":  0,
+		"EXECUTION_TYPE_NEW":          1,
+		"EXECUTION_TYPE_PARTIAL_FILL": 2,
+		"EXECUTION_TYPE_FILL":         3,
+		"EXECUTION_TYPE_CANCELLED":    4,
+		"EXECUTION_TYPE_REPLACED":     5,
+		"EXECUTION_TYPE_REJECTED":     6,
+		"EXECUTION_TYPE_EXPIRED":      7,
+	}
+)
+
+func (x ExecutionType) Enum() *ExecutionType {
+	p := new(ExecutionType)
+	*p = x
+	return p
+}
+
+func (x ExecutionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_v1_market_proto_enumTypes[7].Descriptor()
+}
+
+func (ExecutionType) Type() protoreflect.EnumType {
+	return &file_market_v1_market_proto_enumTypes[7]
+}
+
+func (x ExecutionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionType.Descriptor instead.
+func (ExecutionType) EnumDescriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{7}
+}
+
 type StreamTradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IntervalMs    int32                  `protobuf:"varint,1,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
@@ -335,11 +805,17 @@ func (x *StreamTickersRequest) GetSymbols() []string {
 }
 
 type StreamTickersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Change        *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Symbol    string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Price     float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Change    *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
+	Timestamp int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Set on updates that report a listing change instead of a price. A
+	// LISTED update goes to every stream; a DELISTED one goes to streams
+	// subscribed to the symbol, which then receive nothing more for it.
+	Status TickerStatus `protobuf:"varint,5,opt,name=status,proto3,enum=market.v1.TickerStatus" json:"status,omitempty"`
+	// Company name, set on LISTED updates.
+	Name          string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -402,41 +878,1705 @@ func (x *StreamTickersResponse) GetTimestamp() int64 {
 	return 0
 }
 
-var File_market_v1_market_proto protoreflect.FileDescriptor
+func (x *StreamTickersResponse) GetStatus() TickerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TickerStatus_TICKER_STATUS_UNSPECIFIED
+}
 
-const file_market_v1_market_proto_rawDesc = "" +
-	"\n" +
-	"\x16market/v1/market.proto\x12\tmarket.v1\x1a\x1egoogle/protobuf/wrappers.proto\"6\n" +
-	"\x13StreamTradesRequest\x12\x1f\n" +
-	"\vinterval_ms\x18\x01 \x01(\x05R\n" +
-	"intervalMs\"\x9a\x01\n" +
-	"\x14StreamTradesResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06ticker\x18\x02 \x01(\tR\x06ticker\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x12\n" +
-	"\x04size\x18\x04 \x01(\x05R\x04size\x12\x12\n" +
-	"\x04side\x18\x05 \x01(\tR\x04side\x12\x1c\n" +
-	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp\"\x13\n" +
-	"\x11GetTickersRequest\"E\n" +
-	"\x12GetTickersResponse\x12/\n" +
-	"\atickers\x18\x01 \x03(\v2\x15.market.v1.TickerDataR\atickers\"N\n" +
-	"\n" +
-	"TickerData\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"0\n" +
-	"\x14StreamTickersRequest\x12\x18\n" +
-	"\asymbols\x18\x01 \x03(\tR\asymbols\"\x98\x01\n" +
-	"\x15StreamTickersResponse\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x01R\x05price\x123\n" +
-	"\x06change\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x06change\x12\x1c\n" +
-	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp2\x87\x02\n" +
-	"\rMarketService\x12Q\n" +
-	"\fStreamTrades\x12\x1e.market.v1.StreamTradesRequest\x1a\x1f.market.v1.StreamTradesResponse0\x01\x12K\n" +
-	"\n" +
-	"GetTickers\x12\x1c.market.v1.GetTickersRequest\x1a\x1d.market.v1.GetTickersResponse\"\x00\x12V\n" +
-	"\rStreamTickers\x12\x1f.market.v1.StreamTickersRequest\x1a .market.v1.StreamTickersResponse(\x010\x01B#Z!market-engine-go/gen/go/market/v1b\x06proto3"
+func (x *StreamTickersResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Order struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol         string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side           OrderSide              `protobuf:"varint,3,opt,name=side,proto3,enum=market.v1.OrderSide" json:"side,omitempty"`
+	Type           OrderType              `protobuf:"varint,4,opt,name=type,proto3,enum=market.v1.OrderType" json:"type,omitempty"`
+	Price          float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity       int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FilledQuantity int64                  `protobuf:"varint,7,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	Status         OrderStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=market.v1.OrderStatus" json:"status,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Placed by the engine to close out an account in a margin call.
+	Liquidation   bool        `protobuf:"varint,11,opt,name=liquidation,proto3" json:"liquidation,omitempty"`
+	TimeInForce   TimeInForce `protobuf:"varint,12,opt,name=time_in_force,json=timeInForce,proto3,enum=market.v1.TimeInForce" json:"time_in_force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_market_v1_market_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Order) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *Order) GetType() OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
+
+func (x *Order) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Order) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetFilledQuantity() int64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetLiquidation() bool {
+	if x != nil {
+		return x.Liquidation
+	}
+	return false
+}
+
+func (x *Order) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+type Trade struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol    string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Price     float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity  int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Side      OrderSide              `protobuf:"varint,5,opt,name=side,proto3,enum=market.v1.OrderSide" json:"side,omitempty"`
+	Timestamp int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// What the order's account paid on the fill, on trade confirmations.
+	Fees          *Fees `protobuf:"bytes,7,opt,name=fees,proto3" json:"fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	mi := &file_market_v1_market_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Trade) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Trade) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Trade) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Trade) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Trade) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *Trade) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Trade) GetFees() *Fees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+// Fees and taxes on one side of a fill, in rupiah.
+type Fees struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Commission float64                `protobuf:"fixed64,1,opt,name=commission,proto3" json:"commission,omitempty"`
+	// Exchange transaction levy.
+	Levy     float64 `protobuf:"fixed64,2,opt,name=levy,proto3" json:"levy,omitempty"`
+	Clearing float64 `protobuf:"fixed64,3,opt,name=clearing,proto3" json:"clearing,omitempty"`
+	// VAT on the commission.
+	Vat float64 `protobuf:"fixed64,4,opt,name=vat,proto3" json:"vat,omitempty"`
+	// Final income tax on sells.
+	SalesTax      float64 `protobuf:"fixed64,5,opt,name=sales_tax,json=salesTax,proto3" json:"sales_tax,omitempty"`
+	Total         float64 `protobuf:"fixed64,6,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fees) Reset() {
+	*x = Fees{}
+	mi := &file_market_v1_market_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fees) ProtoMessage() {}
+
+func (x *Fees) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fees.ProtoReflect.Descriptor instead.
+func (*Fees) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Fees) GetCommission() float64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *Fees) GetLevy() float64 {
+	if x != nil {
+		return x.Levy
+	}
+	return 0
+}
+
+func (x *Fees) GetClearing() float64 {
+	if x != nil {
+		return x.Clearing
+	}
+	return 0
+}
+
+func (x *Fees) GetVat() float64 {
+	if x != nil {
+		return x.Vat
+	}
+	return 0
+}
+
+func (x *Fees) GetSalesTax() float64 {
+	if x != nil {
+		return x.SalesTax
+	}
+	return 0
+}
+
+func (x *Fees) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PlaceOrderRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side   OrderSide              `protobuf:"varint,2,opt,name=side,proto3,enum=market.v1.OrderSide" json:"side,omitempty"`
+	Type   OrderType              `protobuf:"varint,3,opt,name=type,proto3,enum=market.v1.OrderType" json:"type,omitempty"`
+	// Limit price; ignored for market orders.
+	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	// Quantity in shares, a multiple of the 100-share board lot.
+	Quantity int64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Account the order is placed for, which must hold the cash or shares.
+	AccountId     string      `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TimeInForce   TimeInForce `protobuf:"varint,7,opt,name=time_in_force,json=timeInForce,proto3,enum=market.v1.TimeInForce" json:"time_in_force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlaceOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *PlaceOrderRequest) GetType() OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
+
+func (x *PlaceOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+type PlaceOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Trades        []*Trade               `protobuf:"bytes,2,rep,name=trades,proto3" json:"trades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderResponse) Reset() {
+	*x = PlaceOrderResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderResponse) ProtoMessage() {}
+
+func (x *PlaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PlaceOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *PlaceOrderResponse) GetTrades() []*Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetOrderBookRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Number of price levels per side; zero returns the full book.
+	Depth         int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderBookRequest) Reset() {
+	*x = GetOrderBookRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookRequest) ProtoMessage() {}
+
+func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderBookRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOrderBookRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetOrderBookRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type PriceLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Volume        int64                  `protobuf:"varint,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Frequency     int32                  `protobuf:"varint,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLevel) Reset() {
+	*x = PriceLevel{}
+	mi := &file_market_v1_market_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLevel) ProtoMessage() {}
+
+func (x *PriceLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLevel.ProtoReflect.Descriptor instead.
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PriceLevel) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceLevel) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *PriceLevel) GetFrequency() int32 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+type GetOrderBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Bids          []*PriceLevel          `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks          []*PriceLevel          `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderBookResponse) Reset() {
+	*x = GetOrderBookResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookResponse) ProtoMessage() {}
+
+func (x *GetOrderBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderBookResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetOrderBookResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetOrderBookResponse) GetBids() []*PriceLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *GetOrderBookResponse) GetAsks() []*PriceLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+type GetDailyHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Inclusive bounds as YYYY-MM-DD; empty bounds are open.
+	FromDate string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate   string `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	// Keeps only the most recent days; zero returns every day in range.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Adjusts bars before each split, dividend and rights issue that has gone
+	// ex to the prices and share units in effect today.
+	Adjusted      bool `protobuf:"varint,5,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyHistoryRequest) Reset() {
+	*x = GetDailyHistoryRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyHistoryRequest) ProtoMessage() {}
+
+func (x *GetDailyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDailyHistoryRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetDailyHistoryRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetDailyHistoryRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetDailyHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDailyHistoryRequest) GetAdjusted() bool {
+	if x != nil {
+		return x.Adjusted
+	}
+	return false
+}
+
+type DailyBar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Trading day as YYYY-MM-DD.
+	Date          string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	High          float64 `protobuf:"fixed64,2,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64 `protobuf:"fixed64,3,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64 `protobuf:"fixed64,4,opt,name=close,proto3" json:"close,omitempty"`
+	Change        float64 `protobuf:"fixed64,5,opt,name=change,proto3" json:"change,omitempty"`
+	Volume        int64   `protobuf:"varint,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Value         int64   `protobuf:"varint,7,opt,name=value,proto3" json:"value,omitempty"`
+	Frequency     int64   `protobuf:"varint,8,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyBar) Reset() {
+	*x = DailyBar{}
+	mi := &file_market_v1_market_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyBar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyBar) ProtoMessage() {}
+
+func (x *DailyBar) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyBar.ProtoReflect.Descriptor instead.
+func (*DailyBar) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DailyBar) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyBar) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *DailyBar) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *DailyBar) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *DailyBar) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *DailyBar) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *DailyBar) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *DailyBar) GetFrequency() int64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+type GetDailyHistoryResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Symbol   string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bars     []*DailyBar            `protobuf:"bytes,3,rep,name=bars,proto3" json:"bars,omitempty"`
+	Adjusted bool                   `protobuf:"varint,4,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	// Corporate actions that have gone ex after the first returned day, which
+	// are those adjusted bars are adjusted for.
+	CorporateActions []*CorporateAction `protobuf:"bytes,5,rep,name=corporate_actions,json=corporateActions,proto3" json:"corporate_actions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDailyHistoryResponse) Reset() {
+	*x = GetDailyHistoryResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyHistoryResponse) ProtoMessage() {}
+
+func (x *GetDailyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDailyHistoryResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetDailyHistoryResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDailyHistoryResponse) GetBars() []*DailyBar {
+	if x != nil {
+		return x.Bars
+	}
+	return nil
+}
+
+func (x *GetDailyHistoryResponse) GetAdjusted() bool {
+	if x != nil {
+		return x.Adjusted
+	}
+	return false
+}
+
+func (x *GetDailyHistoryResponse) GetCorporateActions() []*CorporateAction {
+	if x != nil {
+		return x.CorporateActions
+	}
+	return nil
+}
+
+type CorporateAction struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Type   CorporateActionType    `protobuf:"varint,2,opt,name=type,proto3,enum=market.v1.CorporateActionType" json:"type,omitempty"`
+	// Dates as YYYY-MM-DD; record and payment dates may be empty.
+	ExDate      string `protobuf:"bytes,3,opt,name=ex_date,json=exDate,proto3" json:"ex_date,omitempty"`
+	RecordDate  string `protobuf:"bytes,4,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	PaymentDate string `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	// Cash dividend per share.
+	Amount float64 `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// New shares per old share: 5 for a 1:5 split, 0.2 for a 5:1 reverse
+	// split, 0.25 for rights of one new share per four held.
+	Ratio float64 `protobuf:"fixed64,7,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// Rights issue exercise price.
+	Price       float64 `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Description string  `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the engine has adjusted prices and resting orders for the
+	// action since its reference snapshot.
+	Applied       bool `protobuf:"varint,10,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorporateAction) Reset() {
+	*x = CorporateAction{}
+	mi := &file_market_v1_market_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorporateAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorporateAction) ProtoMessage() {}
+
+func (x *CorporateAction) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorporateAction.ProtoReflect.Descriptor instead.
+func (*CorporateAction) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CorporateAction) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetType() CorporateActionType {
+	if x != nil {
+		return x.Type
+	}
+	return CorporateActionType_CORPORATE_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *CorporateAction) GetExDate() string {
+	if x != nil {
+		return x.ExDate
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetRecordDate() string {
+	if x != nil {
+		return x.RecordDate
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type ListCorporateActionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty lists every symbol.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Inclusive ex-date bounds as YYYY-MM-DD; empty bounds are open.
+	FromDate      string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCorporateActionsRequest) Reset() {
+	*x = ListCorporateActionsRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorporateActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorporateActionsRequest) ProtoMessage() {}
+
+func (x *ListCorporateActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorporateActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCorporateActionsRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCorporateActionsRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListCorporateActionsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListCorporateActionsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type ListCorporateActionsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CorporateActions []*CorporateAction     `protobuf:"bytes,1,rep,name=corporate_actions,json=corporateActions,proto3" json:"corporate_actions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListCorporateActionsResponse) Reset() {
+	*x = ListCorporateActionsResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorporateActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorporateActionsResponse) ProtoMessage() {}
+
+func (x *ListCorporateActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorporateActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCorporateActionsResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCorporateActionsResponse) GetCorporateActions() []*CorporateAction {
+	if x != nil {
+		return x.CorporateActions
+	}
+	return nil
+}
+
+type StreamExecutionReportsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Replays the account's reports from this sequence number before live
+	// ones, to resume after the last report received; zero streams only new
+	// reports.
+	FromSequence  uint64 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamExecutionReportsRequest) Reset() {
+	*x = StreamExecutionReportsRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExecutionReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExecutionReportsRequest) ProtoMessage() {}
+
+func (x *StreamExecutionReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExecutionReportsRequest.ProtoReflect.Descriptor instead.
+func (*StreamExecutionReportsRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StreamExecutionReportsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *StreamExecutionReportsRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+type StreamExecutionReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ExecutionReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamExecutionReportsResponse) Reset() {
+	*x = StreamExecutionReportsResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExecutionReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExecutionReportsResponse) ProtoMessage() {}
+
+func (x *StreamExecutionReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExecutionReportsResponse.ProtoReflect.Descriptor instead.
+func (*StreamExecutionReportsResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamExecutionReportsResponse) GetReport() *ExecutionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// One step in the life of an account's order.
+type ExecutionReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Gapless per account, starting at 1.
+	Sequence  uint64        `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type      ExecutionType `protobuf:"varint,2,opt,name=type,proto3,enum=market.v1.ExecutionType" json:"type,omitempty"`
+	Timestamp int64         `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AccountId string        `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The order as this report leaves it. Rejected orders have no id.
+	Order *Order `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	// The fill of PARTIAL_FILL and FILL reports.
+	LastQuantity       int64   `protobuf:"varint,6,opt,name=last_quantity,json=lastQuantity,proto3" json:"last_quantity,omitempty"`
+	LastPrice          float64 `protobuf:"fixed64,7,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	TradeId            string  `protobuf:"bytes,8,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Fees               *Fees   `protobuf:"bytes,9,opt,name=fees,proto3" json:"fees,omitempty"`
+	CumulativeQuantity int64   `protobuf:"varint,10,opt,name=cumulative_quantity,json=cumulativeQuantity,proto3" json:"cumulative_quantity,omitempty"`
+	// Quantity still working; zero once the order is done.
+	LeavesQuantity int64 `protobuf:"varint,11,opt,name=leaves_quantity,json=leavesQuantity,proto3" json:"leaves_quantity,omitempty"`
+	// Average price of the cumulative quantity.
+	AveragePrice  float64           `protobuf:"fixed64,12,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	RejectReason  OrderRejectReason `protobuf:"varint,13,opt,name=reject_reason,json=rejectReason,proto3,enum=market.v1.OrderRejectReason" json:"reject_reason,omitempty"`
+	Text          string            `protobuf:"bytes,14,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionReport) Reset() {
+	*x = ExecutionReport{}
+	mi := &file_market_v1_market_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionReport) ProtoMessage() {}
+
+func (x *ExecutionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionReport.ProtoReflect.Descriptor instead.
+func (*ExecutionReport) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExecutionReport) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetType() ExecutionType {
+	if x != nil {
+		return x.Type
+	}
+	return ExecutionType_EXECUTION_TYPE_UNSPECIFIED
+}
+
+func (x *ExecutionReport) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ExecutionReport) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ExecutionReport) GetLastQuantity() int64 {
+	if x != nil {
+		return x.LastQuantity
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *ExecutionReport) GetFees() *Fees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *ExecutionReport) GetCumulativeQuantity() int64 {
+	if x != nil {
+		return x.CumulativeQuantity
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetLeavesQuantity() int64 {
+	if x != nil {
+		return x.LeavesQuantity
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetRejectReason() OrderRejectReason {
+	if x != nil {
+		return x.RejectReason
+	}
+	return OrderRejectReason_ORDER_REJECT_REASON_UNSPECIFIED
+}
+
+func (x *ExecutionReport) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+var File_market_v1_market_proto protoreflect.FileDescriptor
+
+const file_market_v1_market_proto_rawDesc = "" +
+	"\n" +
+	"\x16market/v1/market.proto\x12\tmarket.v1\x1a\x1egoogle/protobuf/wrappers.proto\"6\n" +
+	"\x13StreamTradesRequest\x12\x1f\n" +
+	"\vinterval_ms\x18\x01 \x01(\x05R\n" +
+	"intervalMs\"\x9a\x01\n" +
+	"\x14StreamTradesResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06ticker\x18\x02 \x01(\tR\x06ticker\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp\"\x13\n" +
+	"\x11GetTickersRequest\"E\n" +
+	"\x12GetTickersResponse\x12/\n" +
+	"\atickers\x18\x01 \x03(\v2\x15.market.v1.TickerDataR\atickers\"N\n" +
+	"\n" +
+	"TickerData\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"0\n" +
+	"\x14StreamTickersRequest\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"\xdd\x01\n" +
+	"\x15StreamTickersResponse\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x123\n" +
+	"\x06change\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x06change\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.market.v1.TickerStatusR\x06status\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\"\xaa\x03\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12(\n" +
+	"\x04side\x18\x03 \x01(\x0e2\x14.market.v1.OrderSideR\x04side\x12(\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x14.market.v1.OrderTypeR\x04type\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12'\n" +
+	"\x0ffilled_quantity\x18\a \x01(\x03R\x0efilledQuantity\x12.\n" +
+	"\x06status\x18\b \x01(\x0e2\x16.market.v1.OrderStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12 \n" +
+	"\vliquidation\x18\v \x01(\bR\vliquidation\x12:\n" +
+	"\rtime_in_force\x18\f \x01(\x0e2\x16.market.v1.TimeInForceR\vtimeInForce\"\xce\x01\n" +
+	"\x05Trade\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12(\n" +
+	"\x04side\x18\x05 \x01(\x0e2\x14.market.v1.OrderSideR\x04side\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12#\n" +
+	"\x04fees\x18\a \x01(\v2\x0f.market.v1.FeesR\x04fees\"\x9b\x01\n" +
+	"\x04Fees\x12\x1e\n" +
+	"\n" +
+	"commission\x18\x01 \x01(\x01R\n" +
+	"commission\x12\x12\n" +
+	"\x04levy\x18\x02 \x01(\x01R\x04levy\x12\x1a\n" +
+	"\bclearing\x18\x03 \x01(\x01R\bclearing\x12\x10\n" +
+	"\x03vat\x18\x04 \x01(\x01R\x03vat\x12\x1b\n" +
+	"\tsales_tax\x18\x05 \x01(\x01R\bsalesTax\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x01R\x05total\"\x8c\x02\n" +
+	"\x11PlaceOrderRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12(\n" +
+	"\x04side\x18\x02 \x01(\x0e2\x14.market.v1.OrderSideR\x04side\x12(\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x14.market.v1.OrderTypeR\x04type\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\tR\taccountId\x12:\n" +
+	"\rtime_in_force\x18\a \x01(\x0e2\x16.market.v1.TimeInForceR\vtimeInForce\"f\n" +
+	"\x12PlaceOrderResponse\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.market.v1.OrderR\x05order\x12(\n" +
+	"\x06trades\x18\x02 \x03(\v2\x10.market.v1.TradeR\x06trades\"/\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"=\n" +
+	"\x13CancelOrderResponse\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.market.v1.OrderR\x05order\"C\n" +
+	"\x13GetOrderBookRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"X\n" +
+	"\n" +
+	"PriceLevel\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06volume\x18\x02 \x01(\x03R\x06volume\x12\x1c\n" +
+	"\tfrequency\x18\x03 \x01(\x05R\tfrequency\"\x84\x01\n" +
+	"\x14GetOrderBookResponse\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12)\n" +
+	"\x04bids\x18\x02 \x03(\v2\x15.market.v1.PriceLevelR\x04bids\x12)\n" +
+	"\x04asks\x18\x03 \x03(\v2\x15.market.v1.PriceLevelR\x04asks\"\x98\x01\n" +
+	"\x16GetDailyHistoryRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\badjusted\x18\x05 \x01(\bR\badjusted\"\xbe\x01\n" +
+	"\bDailyBar\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04high\x18\x02 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x03 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x04 \x01(\x01R\x05close\x12\x16\n" +
+	"\x06change\x18\x05 \x01(\x01R\x06change\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x03R\x06volume\x12\x14\n" +
+	"\x05value\x18\a \x01(\x03R\x05value\x12\x1c\n" +
+	"\tfrequency\x18\b \x01(\x03R\tfrequency\"\xd3\x01\n" +
+	"\x17GetDailyHistoryResponse\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x04bars\x18\x03 \x03(\v2\x13.market.v1.DailyBarR\x04bars\x12\x1a\n" +
+	"\badjusted\x18\x04 \x01(\bR\badjusted\x12G\n" +
+	"\x11corporate_actions\x18\x05 \x03(\v2\x1a.market.v1.CorporateActionR\x10corporateActions\"\xba\x02\n" +
+	"\x0fCorporateAction\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.market.v1.CorporateActionTypeR\x04type\x12\x17\n" +
+	"\aex_date\x18\x03 \x01(\tR\x06exDate\x12\x1f\n" +
+	"\vrecord_date\x18\x04 \x01(\tR\n" +
+	"recordDate\x12!\n" +
+	"\fpayment_date\x18\x05 \x01(\tR\vpaymentDate\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05ratio\x18\a \x01(\x01R\x05ratio\x12\x14\n" +
+	"\x05price\x18\b \x01(\x01R\x05price\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12\x18\n" +
+	"\aapplied\x18\n" +
+	" \x01(\bR\aapplied\"k\n" +
+	"\x1bListCorporateActionsRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\"g\n" +
+	"\x1cListCorporateActionsResponse\x12G\n" +
+	"\x11corporate_actions\x18\x01 \x03(\v2\x1a.market.v1.CorporateActionR\x10corporateActions\"c\n" +
+	"\x1dStreamExecutionReportsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12#\n" +
+	"\rfrom_sequence\x18\x02 \x01(\x04R\ffromSequence\"T\n" +
+	"\x1eStreamExecutionReportsResponse\x122\n" +
+	"\x06report\x18\x01 \x01(\v2\x1a.market.v1.ExecutionReportR\x06report\"\x9a\x04\n" +
+	"\x0fExecutionReport\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.market.v1.ExecutionTypeR\x04type\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\tR\taccountId\x12&\n" +
+	"\x05order\x18\x05 \x01(\v2\x10.market.v1.OrderR\x05order\x12#\n" +
+	"\rlast_quantity\x18\x06 \x01(\x03R\flastQuantity\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\a \x01(\x01R\tlastPrice\x12\x19\n" +
+	"\btrade_id\x18\b \x01(\tR\atradeId\x12#\n" +
+	"\x04fees\x18\t \x01(\v2\x0f.market.v1.FeesR\x04fees\x12/\n" +
+	"\x13cumulative_quantity\x18\n" +
+	" \x01(\x03R\x12cumulativeQuantity\x12'\n" +
+	"\x0fleaves_quantity\x18\v \x01(\x03R\x0eleavesQuantity\x12#\n" +
+	"\raverage_price\x18\f \x01(\x01R\faveragePrice\x12A\n" +
+	"\rreject_reason\x18\r \x01(\x0e2\x1c.market.v1.OrderRejectReasonR\frejectReason\x12\x12\n" +
+	"\x04text\x18\x0e \x01(\tR\x04text*c\n" +
+	"\fTickerStatus\x12\x1d\n" +
+	"\x19TICKER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TICKER_STATUS_LISTED\x10\x01\x12\x1a\n" +
+	"\x16TICKER_STATUS_DELISTED\x10\x02*P\n" +
+	"\tOrderSide\x12\x1a\n" +
+	"\x16ORDER_SIDE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eORDER_SIDE_BUY\x10\x01\x12\x13\n" +
+	"\x0fORDER_SIDE_SELL\x10\x02*T\n" +
+	"\tOrderType\x12\x1a\n" +
+	"\x16ORDER_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ORDER_TYPE_LIMIT\x10\x01\x12\x15\n" +
+	"\x11ORDER_TYPE_MARKET\x10\x02*\xce\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ORDER_STATUS_NEW\x10\x01\x12!\n" +
+	"\x1dORDER_STATUS_PARTIALLY_FILLED\x10\x02\x12\x17\n" +
+	"\x13ORDER_STATUS_FILLED\x10\x03\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x04\x12\x18\n" +
+	"\x14ORDER_STATUS_EXPIRED\x10\x05\x12\x19\n" +
+	"\x15ORDER_STATUS_REJECTED\x10\x06*Z\n" +
+	"\vTimeInForce\x12\x1d\n" +
+	"\x19TIME_IN_FORCE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TIME_IN_FORCE_GTC\x10\x01\x12\x15\n" +
+	"\x11TIME_IN_FORCE_DAY\x10\x02*\xef\x03\n" +
+	"\x11OrderRejectReason\x12#\n" +
+	"\x1fORDER_REJECT_REASON_UNSPECIFIED\x10\x00\x121\n" +
+	"-ORDER_REJECT_REASON_INSUFFICIENT_BUYING_POWER\x10\x01\x12+\n" +
+	"'ORDER_REJECT_REASON_INSUFFICIENT_SHARES\x10\x02\x12'\n" +
+	"#ORDER_REJECT_REASON_MAX_ORDER_VALUE\x10\x03\x12*\n" +
+	"&ORDER_REJECT_REASON_MAX_ORDER_QUANTITY\x10\x04\x12\"\n" +
+	"\x1eORDER_REJECT_REASON_PRICE_BAND\x10\x05\x12$\n" +
+	" ORDER_REJECT_REASON_MAX_POSITION\x10\x06\x12\"\n" +
+	"\x1eORDER_REJECT_REASON_DAILY_LOSS\x10\a\x12\"\n" +
+	"\x1eORDER_REJECT_REASON_SELF_TRADE\x10\b\x12\"\n" +
+	"\x1eORDER_REJECT_REASON_RATE_LIMIT\x10\t\x12%\n" +
+	"!ORDER_REJECT_REASON_SYMBOL_HALTED\x10\n" +
+	"\x12#\n" +
+	"\x1fORDER_REJECT_REASON_MARGIN_CALL\x10\v*\xd2\x01\n" +
+	"\x13CorporateActionType\x12%\n" +
+	"!CORPORATE_ACTION_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCORPORATE_ACTION_TYPE_DIVIDEND\x10\x01\x12\x1f\n" +
+	"\x1bCORPORATE_ACTION_TYPE_SPLIT\x10\x02\x12'\n" +
+	"#CORPORATE_ACTION_TYPE_REVERSE_SPLIT\x10\x03\x12&\n" +
+	"\"CORPORATE_ACTION_TYPE_RIGHTS_ISSUE\x10\x04*\xf5\x01\n" +
+	"\rExecutionType\x12\x1e\n" +
+	"\x1aEXECUTION_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EXECUTION_TYPE_NEW\x10\x01\x12\x1f\n" +
+	"\x1bEXECUTION_TYPE_PARTIAL_FILL\x10\x02\x12\x17\n" +
+	"\x13EXECUTION_TYPE_FILL\x10\x03\x12\x1c\n" +
+	"\x18EXECUTION_TYPE_CANCELLED\x10\x04\x12\x1b\n" +
+	"\x17EXECUTION_TYPE_REPLACED\x10\x05\x12\x1b\n" +
+	"\x17EXECUTION_TYPE_REJECTED\x10\x06\x12\x1a\n" +
+	"\x16EXECUTION_TYPE_EXPIRED\x10\a2\xaf\x06\n" +
+	"\rMarketService\x12Q\n" +
+	"\fStreamTrades\x12\x1e.market.v1.StreamTradesRequest\x1a\x1f.market.v1.StreamTradesResponse0\x01\x12K\n" +
+	"\n" +
+	"GetTickers\x12\x1c.market.v1.GetTickersRequest\x1a\x1d.market.v1.GetTickersResponse\"\x00\x12V\n" +
+	"\rStreamTickers\x12\x1f.market.v1.StreamTickersRequest\x1a .market.v1.StreamTickersResponse(\x010\x01\x12K\n" +
+	"\n" +
+	"PlaceOrder\x12\x1c.market.v1.PlaceOrderRequest\x1a\x1d.market.v1.PlaceOrderResponse\"\x00\x12N\n" +
+	"\vCancelOrder\x12\x1d.market.v1.CancelOrderRequest\x1a\x1e.market.v1.CancelOrderResponse\"\x00\x12Q\n" +
+	"\fGetOrderBook\x12\x1e.market.v1.GetOrderBookRequest\x1a\x1f.market.v1.GetOrderBookResponse\"\x00\x12Z\n" +
+	"\x0fGetDailyHistory\x12!.market.v1.GetDailyHistoryRequest\x1a\".market.v1.GetDailyHistoryResponse\"\x00\x12i\n" +
+	"\x14ListCorporateActions\x12&.market.v1.ListCorporateActionsRequest\x1a'.market.v1.ListCorporateActionsResponse\"\x00\x12o\n" +
+	"\x16StreamExecutionReports\x12(.market.v1.StreamExecutionReportsRequest\x1a).market.v1.StreamExecutionReportsResponse0\x01B#Z!market-engine-go/gen/go/market/v1b\x06proto3"
 
 var (
 	file_market_v1_market_proto_rawDescOnce sync.Once
@@ -450,31 +2590,95 @@ func file_market_v1_market_proto_rawDescGZIP() []byte {
 	return file_market_v1_market_proto_rawDescData
 }
 
-var file_market_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_market_v1_market_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_market_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_market_v1_market_proto_goTypes = []any{
-	(*StreamTradesRequest)(nil),   // 0: market.v1.StreamTradesRequest
-	(*StreamTradesResponse)(nil),  // 1: market.v1.StreamTradesResponse
-	(*GetTickersRequest)(nil),     // 2: market.v1.GetTickersRequest
-	(*GetTickersResponse)(nil),    // 3: market.v1.GetTickersResponse
-	(*TickerData)(nil),            // 4: market.v1.TickerData
-	(*StreamTickersRequest)(nil),  // 5: market.v1.StreamTickersRequest
-	(*StreamTickersResponse)(nil), // 6: market.v1.StreamTickersResponse
-	(*wrapperspb.Int32Value)(nil), // 7: google.protobuf.Int32Value
+	(TickerStatus)(0),                      // 0: market.v1.TickerStatus
+	(OrderSide)(0),                         // 1: market.v1.OrderSide
+	(OrderType)(0),                         // 2: market.v1.OrderType
+	(OrderStatus)(0),                       // 3: market.v1.OrderStatus
+	(TimeInForce)(0),                       // 4: market.v1.TimeInForce
+	(OrderRejectReason)(0),                 // 5: market.v1.OrderRejectReason
+	(CorporateActionType)(0),               // 6: market.v1.CorporateActionType
+	(ExecutionType)(0),                     // 7: market.v1.ExecutionType
+	(*StreamTradesRequest)(nil),            // 8: market.v1.StreamTradesRequest
+	(*StreamTradesResponse)(nil),           // 9: market.v1.StreamTradesResponse
+	(*GetTickersRequest)(nil),              // 10: market.v1.GetTickersRequest
+	(*GetTickersResponse)(nil),             // 11: market.v1.GetTickersResponse
+	(*TickerData)(nil),                     // 12: market.v1.TickerData
+	(*StreamTickersRequest)(nil),           // 13: market.v1.StreamTickersRequest
+	(*StreamTickersResponse)(nil),          // 14: market.v1.StreamTickersResponse
+	(*Order)(nil),                          // 15: market.v1.Order
+	(*Trade)(nil),                          // 16: market.v1.Trade
+	(*Fees)(nil),                           // 17: market.v1.Fees
+	(*PlaceOrderRequest)(nil),              // 18: market.v1.PlaceOrderRequest
+	(*PlaceOrderResponse)(nil),             // 19: market.v1.PlaceOrderResponse
+	(*CancelOrderRequest)(nil),             // 20: market.v1.CancelOrderRequest
+	(*CancelOrderResponse)(nil),            // 21: market.v1.CancelOrderResponse
+	(*GetOrderBookRequest)(nil),            // 22: market.v1.GetOrderBookRequest
+	(*PriceLevel)(nil),                     // 23: market.v1.PriceLevel
+	(*GetOrderBookResponse)(nil),           // 24: market.v1.GetOrderBookResponse
+	(*GetDailyHistoryRequest)(nil),         // 25: market.v1.GetDailyHistoryRequest
+	(*DailyBar)(nil),                       // 26: market.v1.DailyBar
+	(*GetDailyHistoryResponse)(nil),        // 27: market.v1.GetDailyHistoryResponse
+	(*CorporateAction)(nil),                // 28: market.v1.CorporateAction
+	(*ListCorporateActionsRequest)(nil),    // 29: market.v1.ListCorporateActionsRequest
+	(*ListCorporateActionsResponse)(nil),   // 30: market.v1.ListCorporateActionsResponse
+	(*StreamExecutionReportsRequest)(nil),  // 31: market.v1.StreamExecutionReportsRequest
+	(*StreamExecutionReportsResponse)(nil), // 32: market.v1.StreamExecutionReportsResponse
+	(*ExecutionReport)(nil),                // 33: market.v1.ExecutionReport
+	(*wrapperspb.Int32Value)(nil),          // 34: google.protobuf.Int32Value
 }
 var file_market_v1_market_proto_depIdxs = []int32{
-	4, // 0: market.v1.GetTickersResponse.tickers:type_name -> market.v1.TickerData
-	7, // 1: market.v1.StreamTickersResponse.change:type_name -> google.protobuf.Int32Value
-	0, // 2: market.v1.MarketService.StreamTrades:input_type -> market.v1.StreamTradesRequest
-	2, // 3: market.v1.MarketService.GetTickers:input_type -> market.v1.GetTickersRequest
-	5, // 4: market.v1.MarketService.StreamTickers:input_type -> market.v1.StreamTickersRequest
-	1, // 5: market.v1.MarketService.StreamTrades:output_type -> market.v1.StreamTradesResponse
-	3, // 6: market.v1.MarketService.GetTickers:output_type -> market.v1.GetTickersResponse
-	6, // 7: market.v1.MarketService.StreamTickers:output_type -> market.v1.StreamTickersResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: market.v1.GetTickersResponse.tickers:type_name -> market.v1.TickerData
+	34, // 1: market.v1.StreamTickersResponse.change:type_name -> google.protobuf.Int32Value
+	0,  // 2: market.v1.StreamTickersResponse.status:type_name -> market.v1.TickerStatus
+	1,  // 3: market.v1.Order.side:type_name -> market.v1.OrderSide
+	2,  // 4: market.v1.Order.type:type_name -> market.v1.OrderType
+	3,  // 5: market.v1.Order.status:type_name -> market.v1.OrderStatus
+	4,  // 6: market.v1.Order.time_in_force:type_name -> market.v1.TimeInForce
+	1,  // 7: market.v1.Trade.side:type_name -> market.v1.OrderSide
+	17, // 8: market.v1.Trade.fees:type_name -> market.v1.Fees
+	1,  // 9: market.v1.PlaceOrderRequest.side:type_name -> market.v1.OrderSide
+	2,  // 10: market.v1.PlaceOrderRequest.type:type_name -> market.v1.OrderType
+	4,  // 11: market.v1.PlaceOrderRequest.time_in_force:type_name -> market.v1.TimeInForce
+	15, // 12: market.v1.PlaceOrderResponse.order:type_name -> market.v1.Order
+	16, // 13: market.v1.PlaceOrderResponse.trades:type_name -> market.v1.Trade
+	15, // 14: market.v1.CancelOrderResponse.order:type_name -> market.v1.Order
+	23, // 15: market.v1.GetOrderBookResponse.bids:type_name -> market.v1.PriceLevel
+	23, // 16: market.v1.GetOrderBookResponse.asks:type_name -> market.v1.PriceLevel
+	26, // 17: market.v1.GetDailyHistoryResponse.bars:type_name -> market.v1.DailyBar
+	28, // 18: market.v1.GetDailyHistoryResponse.corporate_actions:type_name -> market.v1.CorporateAction
+	6,  // 19: market.v1.CorporateAction.type:type_name -> market.v1.CorporateActionType
+	28, // 20: market.v1.ListCorporateActionsResponse.corporate_actions:type_name -> market.v1.CorporateAction
+	33, // 21: market.v1.StreamExecutionReportsResponse.report:type_name -> market.v1.ExecutionReport
+	7,  // 22: market.v1.ExecutionReport.type:type_name -> market.v1.ExecutionType
+	15, // 23: market.v1.ExecutionReport.order:type_name -> market.v1.Order
+	17, // 24: market.v1.ExecutionReport.fees:type_name -> market.v1.Fees
+	5,  // 25: market.v1.ExecutionReport.reject_reason:type_name -> market.v1.OrderRejectReason
+	8,  // 26: market.v1.MarketService.StreamTrades:input_type -> market.v1.StreamTradesRequest
+	10, // 27: market.v1.MarketService.GetTickers:input_type -> market.v1.GetTickersRequest
+	13, // 28: market.v1.MarketService.StreamTickers:input_type -> market.v1.StreamTickersRequest
+	18, // 29: market.v1.MarketService.PlaceOrder:input_type -> market.v1.PlaceOrderRequest
+	20, // 30: market.v1.MarketService.CancelOrder:input_type -> market.v1.CancelOrderRequest
+	22, // 31: market.v1.MarketService.GetOrderBook:input_type -> market.v1.GetOrderBookRequest
+	25, // 32: market.v1.MarketService.GetDailyHistory:input_type -> market.v1.GetDailyHistoryRequest
+	29, // 33: market.v1.MarketService.ListCorporateActions:input_type -> market.v1.ListCorporateActionsRequest
+	31, // 34: market.v1.MarketService.StreamExecutionReports:input_type -> market.v1.StreamExecutionReportsRequest
+	9,  // 35: market.v1.MarketService.StreamTrades:output_type -> market.v1.StreamTradesResponse
+	11, // 36: market.v1.MarketService.GetTickers:output_type -> market.v1.GetTickersResponse
+	14, // 37: market.v1.MarketService.StreamTickers:output_type -> market.v1.StreamTickersResponse
+	19, // 38: market.v1.MarketService.PlaceOrder:output_type -> market.v1.PlaceOrderResponse
+	21, // 39: market.v1.MarketService.CancelOrder:output_type -> market.v1.CancelOrderResponse
+	24, // 40: market.v1.MarketService.GetOrderBook:output_type -> market.v1.GetOrderBookResponse
+	27, // 41: market.v1.MarketService.GetDailyHistory:output_type -> market.v1.GetDailyHistoryResponse
+	30, // 42: market.v1.MarketService.ListCorporateActions:output_type -> market.v1.ListCorporateActionsResponse
+	32, // 43: market.v1.MarketService.StreamExecutionReports:output_type -> market.v1.StreamExecutionReportsResponse
+	35, // [35:44] is the sub-list for method output_type
+	26, // [26:35] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_market_v1_market_proto_init() }
@@ -487,13 +2691,14 @@ func file_market_v1_market_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_market_v1_market_proto_rawDesc), len(file_market_v1_market_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      8,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_market_v1_market_proto_goTypes,
 		DependencyIndexes: file_market_v1_market_proto_depIdxs,
+		EnumInfos:         file_market_v1_market_proto_enumTypes,
 		MessageInfos:      file_market_v1_market_proto_msgTypes,
 	}.Build()
 	File_market_v1_market_proto = out.File
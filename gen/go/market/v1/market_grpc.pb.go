@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MarketService_StreamTrades_FullMethodName  = "/market.v1.MarketService/StreamTrades"
-	MarketService_GetTickers_FullMethodName    = "/market.v1.MarketService/GetTickers"
-	MarketService_StreamTickers_FullMethodName = "/market.v1.MarketService/StreamTickers"
+	MarketService_StreamTrades_FullMethodName           = "/market.v1.MarketService/StreamTrades"
+	MarketService_GetTickers_FullMethodName             = "/market.v1.MarketService/GetTickers"
+	MarketService_StreamTickers_FullMethodName          = "/market.v1.MarketService/StreamTickers"
+	MarketService_PlaceOrder_FullMethodName             = "/market.v1.MarketService/PlaceOrder"
+	MarketService_CancelOrder_FullMethodName            = "/market.v1.MarketService/CancelOrder"
+	MarketService_GetOrderBook_FullMethodName           = "/market.v1.MarketService/GetOrderBook"
+	MarketService_GetDailyHistory_FullMethodName        = "/market.v1.MarketService/GetDailyHistory"
+	MarketService_ListCorporateActions_FullMethodName   = "/market.v1.MarketService/ListCorporateActions"
+	MarketService_StreamExecutionReports_FullMethodName = "/market.v1.MarketService/StreamExecutionReports"
 )
 
 // MarketServiceClient is the client API for MarketService service.
@@ -31,6 +37,12 @@ type MarketServiceClient interface {
 	StreamTrades(ctx context.Context, in *StreamTradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamTradesResponse], error)
 	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
 	StreamTickers(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamTickersRequest, StreamTickersResponse], error)
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*GetOrderBookResponse, error)
+	GetDailyHistory(ctx context.Context, in *GetDailyHistoryRequest, opts ...grpc.CallOption) (*GetDailyHistoryResponse, error)
+	ListCorporateActions(ctx context.Context, in *ListCorporateActionsRequest, opts ...grpc.CallOption) (*ListCorporateActionsResponse, error)
+	StreamExecutionReports(ctx context.Context, in *StreamExecutionReportsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamExecutionReportsResponse], error)
 }
 
 type marketServiceClient struct {
@@ -83,6 +95,75 @@ func (c *marketServiceClient) StreamTickers(ctx context.Context, opts ...grpc.Ca
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MarketService_StreamTickersClient = grpc.BidiStreamingClient[StreamTickersRequest, StreamTickersResponse]
 
+func (c *marketServiceClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceOrderResponse)
+	err := c.cc.Invoke(ctx, MarketService_PlaceOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, MarketService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*GetOrderBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderBookResponse)
+	err := c.cc.Invoke(ctx, MarketService_GetOrderBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) GetDailyHistory(ctx context.Context, in *GetDailyHistoryRequest, opts ...grpc.CallOption) (*GetDailyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyHistoryResponse)
+	err := c.cc.Invoke(ctx, MarketService_GetDailyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) ListCorporateActions(ctx context.Context, in *ListCorporateActionsRequest, opts ...grpc.CallOption) (*ListCorporateActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCorporateActionsResponse)
+	err := c.cc.Invoke(ctx, MarketService_ListCorporateActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) StreamExecutionReports(ctx context.Context, in *StreamExecutionReportsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamExecutionReportsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MarketService_ServiceDesc.Streams[2], MarketService_StreamExecutionReports_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamExecutionReportsRequest, StreamExecutionReportsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketService_StreamExecutionReportsClient = grpc.ServerStreamingClient[StreamExecutionReportsResponse]
+
 // MarketServiceServer is the server API for MarketService service.
 // All implementations must embed UnimplementedMarketServiceServer
 // for forward compatibility.
@@ -90,6 +171,12 @@ type MarketServiceServer interface {
 	StreamTrades(*StreamTradesRequest, grpc.ServerStreamingServer[StreamTradesResponse]) error
 	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
 	StreamTickers(grpc.BidiStreamingServer[StreamTickersRequest, StreamTickersResponse]) error
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error)
+	GetDailyHistory(context.Context, *GetDailyHistoryRequest) (*GetDailyHistoryResponse, error)
+	ListCorporateActions(context.Context, *ListCorporateActionsRequest) (*ListCorporateActionsResponse, error)
+	StreamExecutionReports(*StreamExecutionReportsRequest, grpc.ServerStreamingServer[StreamExecutionReportsResponse]) error
 	mustEmbedUnimplementedMarketServiceServer()
 }
 
@@ -109,6 +196,24 @@ func (UnimplementedMarketServiceServer) GetTickers(context.Context, *GetTickersR
 func (UnimplementedMarketServiceServer) StreamTickers(grpc.BidiStreamingServer[StreamTickersRequest, StreamTickersResponse]) error {
 	return status.Error(codes.Unimplemented, "method StreamTickers not implemented")
 }
+func (UnimplementedMarketServiceServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedMarketServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedMarketServiceServer) GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrderBook not implemented")
+}
+func (UnimplementedMarketServiceServer) GetDailyHistory(context.Context, *GetDailyHistoryRequest) (*GetDailyHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDailyHistory not implemented")
+}
+func (UnimplementedMarketServiceServer) ListCorporateActions(context.Context, *ListCorporateActionsRequest) (*ListCorporateActionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCorporateActions not implemented")
+}
+func (UnimplementedMarketServiceServer) StreamExecutionReports(*StreamExecutionReportsRequest, grpc.ServerStreamingServer[StreamExecutionReportsResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamExecutionReports not implemented")
+}
 func (UnimplementedMarketServiceServer) mustEmbedUnimplementedMarketServiceServer() {}
 func (UnimplementedMarketServiceServer) testEmbeddedByValue()                       {}
 
@@ -166,6 +271,107 @@ func _MarketService_StreamTickers_Handler(srv interface{}, stream grpc.ServerStr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MarketService_StreamTickersServer = grpc.BidiStreamingServer[StreamTickersRequest, StreamTickersResponse]
 
+func _MarketService_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_GetOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).GetOrderBook(ctx, req.(*GetOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_GetDailyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).GetDailyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_GetDailyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).GetDailyHistory(ctx, req.(*GetDailyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_ListCorporateActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCorporateActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).ListCorporateActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_ListCorporateActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).ListCorporateActions(ctx, req.(*ListCorporateActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_StreamExecutionReports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamExecutionReportsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MarketServiceServer).StreamExecutionReports(m, &grpc.GenericServerStream[StreamExecutionReportsRequest, StreamExecutionReportsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketService_StreamExecutionReportsServer = grpc.ServerStreamingServer[StreamExecutionReportsResponse]
+
 // MarketService_ServiceDesc is the grpc.ServiceDesc for MarketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +383,26 @@ var MarketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTickers",
 			Handler:    _MarketService_GetTickers_Handler,
 		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _MarketService_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _MarketService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderBook",
+			Handler:    _MarketService_GetOrderBook_Handler,
+		},
+		{
+			MethodName: "GetDailyHistory",
+			Handler:    _MarketService_GetDailyHistory_Handler,
+		},
+		{
+			MethodName: "ListCorporateActions",
+			Handler:    _MarketService_ListCorporateActions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -190,6 +416,11 @@ var MarketService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamExecutionReports",
+			Handler:       _MarketService_StreamExecutionReports_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "market/v1/market.proto",
 }
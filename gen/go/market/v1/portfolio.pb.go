@@ -0,0 +1,490 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: market/v1/portfolio.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An account valued at last prices.
+type Portfolio struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountId   string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Timestamp   int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Cash        float64                `protobuf:"fixed64,3,opt,name=cash,proto3" json:"cash,omitempty"`
+	MarketValue float64                `protobuf:"fixed64,4,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	// Cash plus market value.
+	Equity float64 `protobuf:"fixed64,5,opt,name=equity,proto3" json:"equity,omitempty"`
+	// Net of fees, including positions since closed.
+	RealizedPnl   float64 `protobuf:"fixed64,6,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64 `protobuf:"fixed64,7,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	// Open positions.
+	Positions []*Position `protobuf:"bytes,8,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Exposure of the open positions by sector, largest first.
+	Sectors       []*SectorExposure `protobuf:"bytes,9,rep,name=sectors,proto3" json:"sectors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Portfolio) Reset() {
+	*x = Portfolio{}
+	mi := &file_market_v1_portfolio_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Portfolio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Portfolio) ProtoMessage() {}
+
+func (x *Portfolio) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_portfolio_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Portfolio.ProtoReflect.Descriptor instead.
+func (*Portfolio) Descriptor() ([]byte, []int) {
+	return file_market_v1_portfolio_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Portfolio) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Portfolio) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Portfolio) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *Portfolio) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *Portfolio) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *Portfolio) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *Portfolio) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Portfolio) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *Portfolio) GetSectors() []*SectorExposure {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+type SectorExposure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sector        string                 `protobuf:"bytes,1,opt,name=sector,proto3" json:"sector,omitempty"`
+	MarketValue   float64                `protobuf:"fixed64,2,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,3,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	// Market value as a share of equity.
+	Weight        float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectorExposure) Reset() {
+	*x = SectorExposure{}
+	mi := &file_market_v1_portfolio_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectorExposure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectorExposure) ProtoMessage() {}
+
+func (x *SectorExposure) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_portfolio_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectorExposure.ProtoReflect.Descriptor instead.
+func (*SectorExposure) Descriptor() ([]byte, []int) {
+	return file_market_v1_portfolio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SectorExposure) GetSector() string {
+	if x != nil {
+		return x.Sector
+	}
+	return ""
+}
+
+func (x *SectorExposure) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *SectorExposure) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *SectorExposure) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type GetPortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioRequest) Reset() {
+	*x = GetPortfolioRequest{}
+	mi := &file_market_v1_portfolio_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioRequest) ProtoMessage() {}
+
+func (x *GetPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_portfolio_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_portfolio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPortfolioRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetPortfolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Portfolio     *Portfolio             `protobuf:"bytes,1,opt,name=portfolio,proto3" json:"portfolio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioResponse) Reset() {
+	*x = GetPortfolioResponse{}
+	mi := &file_market_v1_portfolio_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioResponse) ProtoMessage() {}
+
+func (x *GetPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_portfolio_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_portfolio_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPortfolioResponse) GetPortfolio() *Portfolio {
+	if x != nil {
+		return x.Portfolio
+	}
+	return nil
+}
+
+type StreamPortfolioRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Least time between updates; zero uses the server default, and shorter
+	// intervals are raised to the server minimum.
+	IntervalMs    int32 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPortfolioRequest) Reset() {
+	*x = StreamPortfolioRequest{}
+	mi := &file_market_v1_portfolio_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPortfolioRequest) ProtoMessage() {}
+
+func (x *StreamPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_portfolio_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*StreamPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_portfolio_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamPortfolioRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *StreamPortfolioRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type StreamPortfolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Portfolio     *Portfolio             `protobuf:"bytes,1,opt,name=portfolio,proto3" json:"portfolio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPortfolioResponse) Reset() {
+	*x = StreamPortfolioResponse{}
+	mi := &file_market_v1_portfolio_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPortfolioResponse) ProtoMessage() {}
+
+func (x *StreamPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_portfolio_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*StreamPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_portfolio_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamPortfolioResponse) GetPortfolio() *Portfolio {
+	if x != nil {
+		return x.Portfolio
+	}
+	return nil
+}
+
+var File_market_v1_portfolio_proto protoreflect.FileDescriptor
+
+const file_market_v1_portfolio_proto_rawDesc = "" +
+	"\n" +
+	"\x19market/v1/portfolio.proto\x12\tmarket.v1\x1a\x17market/v1/account.proto\"\xc9\x02\n" +
+	"\tPortfolio\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x12\n" +
+	"\x04cash\x18\x03 \x01(\x01R\x04cash\x12!\n" +
+	"\fmarket_value\x18\x04 \x01(\x01R\vmarketValue\x12\x16\n" +
+	"\x06equity\x18\x05 \x01(\x01R\x06equity\x12!\n" +
+	"\frealized_pnl\x18\x06 \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\a \x01(\x01R\runrealizedPnl\x121\n" +
+	"\tpositions\x18\b \x03(\v2\x13.market.v1.PositionR\tpositions\x123\n" +
+	"\asectors\x18\t \x03(\v2\x19.market.v1.SectorExposureR\asectors\"\x8a\x01\n" +
+	"\x0eSectorExposure\x12\x16\n" +
+	"\x06sector\x18\x01 \x01(\tR\x06sector\x12!\n" +
+	"\fmarket_value\x18\x02 \x01(\x01R\vmarketValue\x12%\n" +
+	"\x0eunrealized_pnl\x18\x03 \x01(\x01R\runrealizedPnl\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\"4\n" +
+	"\x13GetPortfolioRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"J\n" +
+	"\x14GetPortfolioResponse\x122\n" +
+	"\tportfolio\x18\x01 \x01(\v2\x14.market.v1.PortfolioR\tportfolio\"X\n" +
+	"\x16StreamPortfolioRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vinterval_ms\x18\x02 \x01(\x05R\n" +
+	"intervalMs\"M\n" +
+	"\x17StreamPortfolioResponse\x122\n" +
+	"\tportfolio\x18\x01 \x01(\v2\x14.market.v1.PortfolioR\tportfolio2\xc1\x01\n" +
+	"\x10PortfolioService\x12Q\n" +
+	"\fGetPortfolio\x12\x1e.market.v1.GetPortfolioRequest\x1a\x1f.market.v1.GetPortfolioResponse\"\x00\x12Z\n" +
+	"\x0fStreamPortfolio\x12!.market.v1.StreamPortfolioRequest\x1a\".market.v1.StreamPortfolioResponse0\x01B#Z!market-engine-go/gen/go/market/v1b\x06proto3"
+
+var (
+	file_market_v1_portfolio_proto_rawDescOnce sync.Once
+	file_market_v1_portfolio_proto_rawDescData []byte
+)
+
+func file_market_v1_portfolio_proto_rawDescGZIP() []byte {
+	file_market_v1_portfolio_proto_rawDescOnce.Do(func() {
+		file_market_v1_portfolio_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_market_v1_portfolio_proto_rawDesc), len(file_market_v1_portfolio_proto_rawDesc)))
+	})
+	return file_market_v1_portfolio_proto_rawDescData
+}
+
+var file_market_v1_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_market_v1_portfolio_proto_goTypes = []any{
+	(*Portfolio)(nil),               // 0: market.v1.Portfolio
+	(*SectorExposure)(nil),          // 1: market.v1.SectorExposure
+	(*GetPortfolioRequest)(nil),     // 2: market.v1.GetPortfolioRequest
+	(*GetPortfolioResponse)(nil),    // 3: market.v1.GetPortfolioResponse
+	(*StreamPortfolioRequest)(nil),  // 4: market.v1.StreamPortfolioRequest
+	(*StreamPortfolioResponse)(nil), // 5: market.v1.StreamPortfolioResponse
+	(*Position)(nil),                // 6: market.v1.Position
+}
+var file_market_v1_portfolio_proto_depIdxs = []int32{
+	6, // 0: market.v1.Portfolio.positions:type_name -> market.v1.Position
+	1, // 1: market.v1.Portfolio.sectors:type_name -> market.v1.SectorExposure
+	0, // 2: market.v1.GetPortfolioResponse.portfolio:type_name -> market.v1.Portfolio
+	0, // 3: market.v1.StreamPortfolioResponse.portfolio:type_name -> market.v1.Portfolio
+	2, // 4: market.v1.PortfolioService.GetPortfolio:input_type -> market.v1.GetPortfolioRequest
+	4, // 5: market.v1.PortfolioService.StreamPortfolio:input_type -> market.v1.StreamPortfolioRequest
+	3, // 6: market.v1.PortfolioService.GetPortfolio:output_type -> market.v1.GetPortfolioResponse
+	5, // 7: market.v1.PortfolioService.StreamPortfolio:output_type -> market.v1.StreamPortfolioResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_market_v1_portfolio_proto_init() }
+func file_market_v1_portfolio_proto_init() {
+	if File_market_v1_portfolio_proto != nil {
+		return
+	}
+	file_market_v1_account_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_market_v1_portfolio_proto_rawDesc), len(file_market_v1_portfolio_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_market_v1_portfolio_proto_goTypes,
+		DependencyIndexes: file_market_v1_portfolio_proto_depIdxs,
+		MessageInfos:      file_market_v1_portfolio_proto_msgTypes,
+	}.Build()
+	File_market_v1_portfolio_proto = out.File
+	file_market_v1_portfolio_proto_goTypes = nil
+	file_market_v1_portfolio_proto_depIdxs = nil
+}
@@ -0,0 +1,107 @@
+package accounts
+
+import (
+	"encoding/json"
+	"fmt"
+	"market-engine-go/internal/models"
+	"math"
+	"os"
+)
+
+// FeeSchedule is what a fee tier charges, as fractions of a fill's value
+// except VAT, which is a fraction of the commission.
+type FeeSchedule struct {
+	BuyCommission  float64 `json:"buy_commission"`
+	SellCommission float64 `json:"sell_commission"`
+	Levy           float64 `json:"levy"`
+	Clearing       float64 `json:"clearing"`
+	VAT            float64 `json:"vat"`
+	// SalesTax is the final income tax on the value of sells.
+	SalesTax float64 `json:"sales_tax"`
+}
+
+type FeeSettings struct {
+	// DefaultTier is the tier of accounts opened without one.
+	DefaultTier string                 `json:"default_tier"`
+	Tiers       map[string]FeeSchedule `json:"tiers"`
+}
+
+// DefaultFeeSettings follows typical IDX online broker rates: the exchange
+// levy, KPEI clearing fee, 11% VAT on commission and the 0.1% tax on sells
+// are the same for every tier, and commission falls with the tier.
+func DefaultFeeSettings() FeeSettings {
+	schedule := func(buyCommission float64, sellCommission float64) FeeSchedule {
+		return FeeSchedule{
+			BuyCommission:  buyCommission,
+			SellCommission: sellCommission,
+			Levy:           0.0003,
+			Clearing:       0.0001,
+			VAT:            0.11,
+			SalesTax:       0.001,
+		}
+	}
+
+	return FeeSettings{
+		DefaultTier: "regular",
+		Tiers: map[string]FeeSchedule{
+			"regular":      schedule(0.0015, 0.0025),
+			"active":       schedule(0.0010, 0.0020),
+			"professional": schedule(0.0005, 0.0015),
+		},
+	}
+}
+
+// LoadFeeSettings reads a JSON settings file. A tier in the file replaces
+// the default tier of the same name; other default tiers are kept.
+func LoadFeeSettings(path string) (FeeSettings, error) {
+	settings := DefaultFeeSettings()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, err
+	}
+
+	if _, exists := settings.Tiers[settings.DefaultTier]; !exists {
+		return settings, fmt.Errorf("%w: default tier %s", ErrUnknownTier, settings.DefaultTier)
+	}
+
+	return settings, nil
+}
+
+// Schedule returns a tier's schedule, falling back to the default tier for
+// tiers that are no longer configured.
+func (settings FeeSettings) Schedule(tier string) FeeSchedule {
+	if schedule, exists := settings.Tiers[tier]; exists {
+		return schedule
+	}
+
+	return settings.Tiers[settings.DefaultTier]
+}
+
+// Charge itemizes the fees on one side of a fill worth value.
+func (schedule FeeSchedule) Charge(side string, value float64) models.Fees {
+	fees := models.Fees{
+		Levy:     math.Round(value * schedule.Levy),
+		Clearing: math.Round(value * schedule.Clearing),
+	}
+
+	if side == models.SideBuy {
+		fees.Commission = math.Round(value * schedule.BuyCommission)
+	} else {
+		fees.Commission = math.Round(value * schedule.SellCommission)
+		fees.SalesTax = math.Round(value * schedule.SalesTax)
+	}
+	fees.VAT = math.Round(fees.Commission * schedule.VAT)
+
+	return fees
+}
+
+// buyRate is the share of a buy's value paid in fees, used to hold enough
+// cash for them before the fill.
+func (schedule FeeSchedule) buyRate() float64 {
+	return schedule.BuyCommission*(1+schedule.VAT) + schedule.Levy + schedule.Clearing
+}
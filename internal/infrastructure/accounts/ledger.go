@@ -0,0 +1,758 @@
+package accounts
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"market-engine-go/internal/infrastructure/calendar"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/models"
+	"math"
+	"slices"
+	"sync"
+)
+
+var (
+	ErrUnknownAccount          = errors.New("unknown account")
+	ErrInvalidAmount           = errors.New("invalid amount")
+	ErrInsufficientBuyingPower = errors.New("insufficient buying power")
+	ErrInsufficientShares      = errors.New("insufficient shares")
+	ErrUnknownTier             = errors.New("unknown fee tier")
+	ErrInsufficientFunds       = errors.New("insufficient settled funds")
+	ErrMarginCall              = errors.New("account is in a margin call")
+)
+
+// updateBuffer is how many updates a subscriber can fall behind by before
+// further updates are dropped for it.
+const updateBuffer = 64
+
+// Ledger keeps paper-trading accounts: cash, the cash and shares held for
+// open orders, and positions with their average cost and realized P&L. Fills
+// are charged the fees of the account's tier and settle on the trading
+// calendar. Margin accounts borrow against their positions under Margin.
+// It is the engine's Ledger, so it sees every order and fill as the engine
+// makes them and is journalled and recovered with the engine. Orders whose
+// owner is not an account, such as those of simulated participants, are not
+// checked.
+type Ledger struct {
+	engine *marketengine.MarketEngine
+	fees   FeeSettings
+	// Calendar and Settlement set when fills settle; they must be set
+	// before the engine is restored.
+	Calendar   *calendar.Calendar
+	Settlement SettlementSettings
+	Margin     MarginSettings
+	// marginChecks wakes RunMarginCalls when margin accounts may need
+	// checking.
+	marginChecks chan struct{}
+
+	mu       sync.RWMutex
+	accounts map[string]*account
+	// orders are the open orders of accounts, by order ID.
+	orders map[string]*reservation
+
+	subscriberSequence uint64
+	subscribers        map[uint64]subscriber
+}
+
+type account struct {
+	models.Account
+	positions map[string]*models.Position
+}
+
+// reservation is what an open order holds. The part of it that closes a
+// position holds shares for a sell and nothing for a buy covering a short;
+// the rest holds cash at rate of its value: the whole value and fees of a
+// cash account's buy, or the initial margin of a margin account's order.
+type reservation struct {
+	account   string
+	symbol    string
+	side      string
+	price     float64
+	rate      float64
+	closing   int
+	remaining int
+}
+
+type subscriber struct {
+	account string
+	updates chan models.AccountUpdate
+}
+
+func NewLedger(engine *marketengine.MarketEngine, fees FeeSettings) *Ledger {
+	return &Ledger{
+		engine:      engine,
+		fees:        fees,
+		Calendar:    calendar.Weekdays(),
+		Settlement:  DefaultSettlementSettings(),
+		Margin:      DefaultMarginSettings(),
+		accounts:    make(map[string]*account),
+		orders:      make(map[string]*reservation),
+		subscribers: make(map[uint64]subscriber),
+
+		marginChecks: make(chan struct{}, 1),
+	}
+}
+
+// Open creates an account on a fee tier, the default tier when empty, with
+// an opening cash balance, which may be zero. A margin account can borrow
+// against its positions and sell short.
+func (ledger *Ledger) Open(name string, tier string, cash float64, margin bool, owner string) (models.Account, error) {
+	if cash < 0 || math.IsNaN(cash) || math.IsInf(cash, 0) {
+		return models.Account{}, fmt.Errorf("%w: opening cash must not be negative", ErrInvalidAmount)
+	}
+	if tier == "" {
+		tier = ledger.fees.DefaultTier
+	}
+	if _, exists := ledger.fees.Tiers[tier]; !exists {
+		return models.Account{}, fmt.Errorf("%w: %s", ErrUnknownTier, tier)
+	}
+
+	id, err := newAccountID()
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	_, err = ledger.engine.RecordEvent(models.Event{
+		Type:    models.EventAccountOpened,
+		Account: &models.AccountChange{ID: id, Name: name, Tier: tier, Amount: cash, Margin: margin, Owner: owner},
+	})
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	kind := "cash"
+	if margin {
+		kind = "margin"
+	}
+	log.Printf("[Accounts] Opened %s account %s (%s) on the %s tier with %.0f", kind, id, name, tier, cash)
+	return ledger.Account(id)
+}
+
+// Fund pays cash into an account.
+func (ledger *Ledger) Fund(id string, amount float64) (models.Account, error) {
+	_, err := ledger.engine.RecordEvent(models.Event{
+		Type:    models.EventAccountFunded,
+		Account: &models.AccountChange{ID: id, Amount: amount},
+	})
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	return ledger.Account(id)
+}
+
+// Withdraw pays settled cash out of an account.
+func (ledger *Ledger) Withdraw(id string, amount float64) (models.Account, error) {
+	_, err := ledger.engine.RecordEvent(models.Event{
+		Type:    models.EventAccountWithdrawn,
+		Account: &models.AccountChange{ID: id, Amount: amount},
+	})
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	return ledger.Account(id)
+}
+
+// BuyingPower is what an account can spend on buys, fees included. For a
+// margin account it is the value of marginable stock its excess equity
+// covers at the initial ratio.
+func (ledger *Ledger) BuyingPower(account models.Account) float64 {
+	if account.Margin {
+		return ledger.marginBuyingPower(account, ledger.engine)
+	}
+
+	return account.BuyingPower(ledger.Settlement.SpendUnsettled)
+}
+
+// Withdrawable is the settled cash an account can take out. A margin
+// account cannot take out more than its excess equity.
+func (ledger *Ledger) Withdrawable(account models.Account) float64 {
+	return ledger.withdrawable(account, ledger.engine)
+}
+
+func (ledger *Ledger) withdrawable(account models.Account, market prices) float64 {
+	if account.Margin {
+		return min(account.Withdrawable(), max(ledger.marginStatus(account, market).Excess, 0))
+	}
+
+	return account.Withdrawable()
+}
+
+// Account returns a copy of an account with its positions, by symbol.
+func (ledger *Ledger) Account(id string) (models.Account, error) {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	account, exists := ledger.accounts[id]
+	if !exists {
+		return models.Account{}, ErrUnknownAccount
+	}
+
+	return account.snapshot(), nil
+}
+
+// Exists reports whether id is an account, without copying it.
+func (ledger *Ledger) Exists(id string) bool {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	_, exists := ledger.accounts[id]
+	return exists
+}
+
+// Owner returns the subject who opened an account.
+func (ledger *Ledger) Owner(id string) (string, bool) {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	account, exists := ledger.accounts[id]
+	if !exists {
+		return "", false
+	}
+	return account.Owner, true
+}
+
+// OrderAccount returns the account an open order was placed for.
+func (ledger *Ledger) OrderAccount(orderID string) (string, bool) {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	held, exists := ledger.orders[orderID]
+	if !exists {
+		return "", false
+	}
+	return held.account, true
+}
+
+// Subscribe returns a channel that receives every change to an account, and
+// a function that ends the subscription.
+func (ledger *Ledger) Subscribe(id string) (<-chan models.AccountUpdate, func(), error) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	if _, exists := ledger.accounts[id]; !exists {
+		return nil, nil, ErrUnknownAccount
+	}
+
+	ledger.subscriberSequence++
+	key := ledger.subscriberSequence
+	updates := make(chan models.AccountUpdate, updateBuffer)
+	ledger.subscribers[key] = subscriber{account: id, updates: updates}
+
+	return updates, func() {
+		ledger.mu.Lock()
+		defer ledger.mu.Unlock()
+
+		delete(ledger.subscribers, key)
+	}, nil
+}
+
+func (ledger *Ledger) CheckOrder(order models.Order, market marketengine.MarketView) error {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	account, exists := ledger.accounts[order.Owner]
+	if !exists || order.Liquidation {
+		return nil
+	}
+
+	if account.Margin {
+		return ledger.checkMarginOrder(account, order, market)
+	}
+
+	if order.Side == models.SideBuy {
+		cost := market.Cost(order) * (1 + ledger.fees.Schedule(account.Tier).buyRate())
+		buyingPower := ledger.BuyingPower(account.Account)
+		if cost > buyingPower {
+			return fmt.Errorf("%w: order costs %.0f with fees, %.0f available", ErrInsufficientBuyingPower, cost, buyingPower)
+		}
+		return nil
+	}
+
+	available := 0
+	if position, exists := account.positions[order.Ticker]; exists {
+		available = position.Available()
+	}
+	if order.Quantity > available {
+		return fmt.Errorf("%w: selling %d %s, %d available", ErrInsufficientShares, order.Quantity, order.Ticker, available)
+	}
+
+	return nil
+}
+
+// ChargeFees itemizes the fees of the trade's sides that are accounts.
+func (ledger *Ledger) ChargeFees(trade *models.Trade) {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	value := trade.Price * float64(trade.Size)
+	if buyer, exists := ledger.accounts[trade.Buyer]; exists {
+		fees := ledger.fees.Schedule(buyer.Tier).Charge(models.SideBuy, value)
+		trade.BuyerFees = &fees
+	}
+	if seller, exists := ledger.accounts[trade.Seller]; exists {
+		fees := ledger.fees.Schedule(seller.Tier).Charge(models.SideSell, value)
+		trade.SellerFees = &fees
+	}
+}
+
+func (ledger *Ledger) CheckEvent(event models.Event, market marketengine.MarketView) error {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	if event.Account == nil {
+		return nil
+	}
+
+	_, exists := ledger.accounts[event.Account.ID]
+	switch event.Type {
+	case models.EventAccountOpened:
+		if exists {
+			return fmt.Errorf("account %s already exists", event.Account.ID)
+		}
+	case models.EventAccountFunded:
+		if !exists {
+			return ErrUnknownAccount
+		}
+		if !(event.Account.Amount > 0) || math.IsInf(event.Account.Amount, 0) {
+			return fmt.Errorf("%w: funding must be positive", ErrInvalidAmount)
+		}
+	case models.EventAccountWithdrawn:
+		if !exists {
+			return ErrUnknownAccount
+		}
+		if !(event.Account.Amount > 0) {
+			return fmt.Errorf("%w: withdrawal must be positive", ErrInvalidAmount)
+		}
+		withdrawable := ledger.withdrawable(ledger.accounts[event.Account.ID].snapshot(), market)
+		if event.Account.Amount > withdrawable {
+			return fmt.Errorf("%w: withdrawing %.0f, %.0f withdrawable", ErrInsufficientFunds, event.Account.Amount, withdrawable)
+		}
+	case models.EventMarginCall, models.EventMarginCallMet:
+		if !exists {
+			return ErrUnknownAccount
+		}
+		if !ledger.accounts[event.Account.ID].Margin {
+			return fmt.Errorf("account %s is not a margin account", event.Account.ID)
+		}
+	}
+
+	return nil
+}
+
+func (ledger *Ledger) Apply(event models.Event, replayed bool) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	var updates []models.AccountUpdate
+	publish := func(reason string, account *account, symbols ...string) {
+		updates = append(updates, models.AccountUpdate{
+			Reason:    reason,
+			Timestamp: event.Timestamp,
+			Account:   account.snapshot(),
+			Symbols:   symbols,
+		})
+	}
+
+	switch event.Type {
+	case models.EventAccountOpened:
+		change := event.Account
+		opened := &account{
+			Account: models.Account{
+				ID:        change.ID,
+				Name:      change.Name,
+				Tier:      change.Tier,
+				Margin:    change.Margin,
+				Owner:     change.Owner,
+				CreatedAt: event.Timestamp,
+				Cash:      change.Amount,
+			},
+			positions: make(map[string]*models.Position),
+		}
+		ledger.accounts[change.ID] = opened
+		publish(models.AccountUpdateOpened, opened)
+	case models.EventAccountFunded:
+		if funded, exists := ledger.accounts[event.Account.ID]; exists {
+			funded.Cash += event.Account.Amount
+			publish(models.AccountUpdateFunded, funded)
+			if funded.MarginCall != nil {
+				ledger.wakeMarginChecks()
+			}
+		}
+	case models.EventAccountWithdrawn:
+		if withdrawn, exists := ledger.accounts[event.Account.ID]; exists {
+			withdrawn.Cash -= event.Account.Amount
+			publish(models.AccountUpdateWithdrawn, withdrawn)
+		}
+	case models.EventOrderAccepted:
+		order := event.Order
+		if owner, exists := ledger.accounts[order.Owner]; exists && order.IsOpen() && order.Type == models.OrderTypeLimit {
+			ledger.reserve(owner, *order)
+			publish(models.AccountUpdateOrder, owner, order.Ticker)
+		}
+	case models.EventOrderCancelled, models.EventOrderExpired, models.EventOrderAdjusted:
+		order := event.Order
+		if owner := ledger.release(order.ID); owner != nil {
+			if event.Type == models.EventOrderAdjusted {
+				ledger.reserve(owner, *order)
+			}
+			publish(models.AccountUpdateOrder, owner, order.Ticker)
+		}
+	case models.EventTrade:
+		trade := event.Trade
+		if buyer, exists := ledger.accounts[trade.Buyer]; exists {
+			ledger.fill(buyer, trade.BuyOrderID, models.SideBuy, *trade, trade.BuyerFees)
+			publish(models.AccountUpdateFill, buyer, trade.Ticker)
+			updates[len(updates)-1].Trade, updates[len(updates)-1].Fees = trade, trade.BuyerFees
+		}
+		if seller, exists := ledger.accounts[trade.Seller]; exists {
+			ledger.fill(seller, trade.SellOrderID, models.SideSell, *trade, trade.SellerFees)
+			publish(models.AccountUpdateFill, seller, trade.Ticker)
+			updates[len(updates)-1].Trade, updates[len(updates)-1].Fees = trade, trade.SellerFees
+		}
+	case models.EventSettlement:
+		for _, id := range slices.Sorted(maps.Keys(ledger.accounts)) {
+			holder := ledger.accounts[id]
+			if symbols := holder.settle(event.SettlementDate); len(symbols) > 0 {
+				publish(models.AccountUpdateSettled, holder, symbols...)
+			}
+		}
+	case models.EventMarginCall:
+		if called, exists := ledger.accounts[event.Account.ID]; exists {
+			call := *event.MarginCall
+			called.MarginCall = &call
+			publish(models.AccountUpdateMarginCall, called)
+		}
+	case models.EventMarginCallMet:
+		if called, exists := ledger.accounts[event.Account.ID]; exists {
+			called.MarginCall = nil
+			publish(models.AccountUpdateMarginCallMet, called)
+		}
+	case models.EventPriceUpdate:
+		if !replayed && ledger.holdsOnMargin(event.Ticker) {
+			ledger.wakeMarginChecks()
+		}
+	case models.EventCorporateAction:
+		action := event.CorporateAction
+		for _, id := range slices.Sorted(maps.Keys(ledger.accounts)) {
+			holder := ledger.accounts[id]
+			if ledger.applyCorporateAction(holder, *action) {
+				publish(models.AccountUpdateCorporateAction, holder, action.Code)
+			}
+		}
+	}
+
+	if !replayed {
+		for _, update := range updates {
+			ledger.publish(update)
+		}
+	}
+}
+
+// reserve holds cash or shares for an open order's remaining quantity. The
+// caller must hold ledger.mu.
+func (ledger *Ledger) reserve(owner *account, order models.Order) {
+	held := &reservation{
+		account:   owner.ID,
+		symbol:    order.Ticker,
+		side:      order.Side,
+		price:     order.Price,
+		rate:      ledger.holdRate(owner, order.Ticker, order.Side),
+		remaining: order.Remaining(),
+	}
+	switch {
+	case owner.Margin:
+		held.closing = owner.closing(held.symbol, held.side, held.remaining)
+	case held.side == models.SideSell:
+		held.closing = held.remaining
+	}
+	ledger.orders[order.ID] = held
+
+	if held.side == models.SideSell {
+		owner.position(held.symbol).Reserved += held.closing
+	}
+	owner.Reserved += held.price * float64(held.remaining-held.closing) * held.rate
+}
+
+// holdRate is the share of the value of an order adding to a position that
+// is held for it: the value and fees of a cash account's buy, or the
+// initial margin, and a buy's fees, on a margin account. The caller must
+// hold ledger.mu.
+func (ledger *Ledger) holdRate(owner *account, symbol string, side string) float64 {
+	feeRate := 0.0
+	if side == models.SideBuy {
+		feeRate = ledger.fees.Schedule(owner.Tier).buyRate()
+	}
+
+	if !owner.Margin {
+		return 1 + feeRate
+	}
+
+	return ledger.Margin.rate(symbol, ledger.Margin.InitialRatio) + feeRate
+}
+
+// release frees what an order holds and forgets it, returning its account,
+// or nil when the order is not an account's open order. The caller must
+// hold ledger.mu.
+func (ledger *Ledger) release(orderID string) *account {
+	held, exists := ledger.orders[orderID]
+	if !exists {
+		return nil
+	}
+
+	owner := ledger.accounts[held.account]
+	ledger.unreserve(owner, held, held.remaining)
+	delete(ledger.orders, orderID)
+
+	return owner
+}
+
+// unreserve frees what size shares of an order hold, the part that closes a
+// position first, as that is the part that fills first.
+func (ledger *Ledger) unreserve(owner *account, held *reservation, size int) {
+	closing := min(size, held.closing)
+	held.closing -= closing
+	if held.side == models.SideSell {
+		owner.position(held.symbol).Reserved -= closing
+	}
+
+	owner.Reserved -= held.price * float64(size-closing) * held.rate
+	if owner.Reserved < 1e-6 {
+		owner.Reserved = 0
+	}
+	held.remaining -= size
+}
+
+// fill settles one side of a trade: cash moves at the trade price less
+// fees, the position and its average cost or realized P&L change, and a
+// resting order's reservation shrinks. The caller must hold ledger.mu.
+func (ledger *Ledger) fill(owner *account, orderID string, side string, trade models.Trade, fees *models.Fees) {
+	if held, exists := ledger.orders[orderID]; exists {
+		ledger.unreserve(owner, held, min(trade.Size, held.remaining))
+		if held.remaining <= 0 {
+			delete(ledger.orders, orderID)
+		}
+	}
+
+	position := owner.position(trade.Ticker)
+	value := trade.Price * float64(trade.Size)
+	charged := 0.0
+	if fees != nil {
+		charged = fees.Total()
+	}
+	owner.FeesPaid += charged
+
+	tradeDate := ledger.TradeDate(trade.Timestamp)
+	settlement := models.Settlement{
+		TradeID:        trade.ID,
+		Symbol:         trade.Ticker,
+		Side:           side,
+		Quantity:       trade.Size,
+		Amount:         -(value + charged),
+		TradeDate:      tradeDate,
+		SettlementDate: ledger.settlementDate(tradeDate),
+	}
+
+	quantity := trade.Size
+	if side == models.SideBuy {
+		owner.Cash -= value + charged
+	} else {
+		settlement.Quantity, settlement.Amount = -trade.Size, value-charged
+		owner.Cash += value - charged
+		quantity = -trade.Size
+	}
+	owner.Unsettled = append(owner.Unsettled, settlement)
+
+	realized, closed := move(position, quantity, trade.Price, charged)
+	if !closed {
+		return
+	}
+
+	// Average costs carry fees that do not divide evenly, so realized P&L
+	// is kept to the sen to stop float noise building up.
+	position.RealizedPnL = math.Round((position.RealizedPnL+realized)*100) / 100
+	if !owner.TradingDay.Equal(tradeDate) {
+		owner.TradingDay, owner.DayRealizedPnL = tradeDate, 0
+	}
+	owner.DayRealizedPnL = math.Round((owner.DayRealizedPnL+realized)*100) / 100
+}
+
+// move changes a position by a fill of quantity shares, negative for a
+// sale, at price, and returns the P&L realized on the shares it closes. The
+// fees are split across the shares: on those closed they come off the P&L,
+// and on those opened they are part of the cost of a buy or come off the
+// proceeds of a short sale.
+func move(position *models.Position, quantity int, price float64, fees float64) (float64, bool) {
+	direction := 1
+	if quantity < 0 {
+		direction = -1
+	}
+	feePerShare := fees / float64(abs(quantity))
+
+	closing := 0
+	if position.Quantity*quantity < 0 {
+		closing = min(abs(quantity), abs(position.Quantity))
+	}
+
+	realized := 0.0
+	if closing > 0 {
+		// A sale closes a long position and a buy a short one.
+		realized = (price-position.AverageCost)*float64(closing*-direction) - feePerShare*float64(closing)
+		position.Quantity += closing * direction
+		if position.Quantity == 0 {
+			position.AverageCost = 0
+		}
+	}
+
+	if opening := abs(quantity) - closing; opening > 0 {
+		held := float64(abs(position.Quantity))
+		openPrice := price + feePerShare*float64(direction)
+		position.AverageCost = (position.AverageCost*held + openPrice*float64(opening)) / (held + float64(opening))
+		position.Quantity += opening * direction
+	}
+
+	return realized, closing > 0
+}
+
+// applyCorporateAction moves a holding to the stock's new units: a split
+// scales the shares, rounding down, and divides the average cost, and a
+// cash dividend is paid on the shares held when the stock goes ex. Open
+// orders are adjusted by the engine's ORDER_ADJUSTED events that follow.
+// It reports whether the account holds the stock. The caller must hold
+// ledger.mu.
+func (ledger *Ledger) applyCorporateAction(holder *account, action models.CorporateAction) bool {
+	position, exists := holder.positions[action.Code]
+	if !exists || position.Quantity == 0 {
+		return false
+	}
+
+	switch action.Type {
+	case models.CorporateActionSplit, models.CorporateActionReverseSplit:
+		position.Quantity = int(math.Floor(float64(position.Quantity) * action.VolumeFactor()))
+		position.AverageCost /= action.VolumeFactor()
+		for i, settlement := range holder.Unsettled {
+			if settlement.Symbol == action.Code {
+				holder.Unsettled[i].Quantity = int(math.Round(float64(settlement.Quantity) * action.VolumeFactor()))
+			}
+		}
+	case models.CorporateActionDividend:
+		income := action.Amount * float64(position.Quantity)
+		holder.Cash += income
+		position.RealizedPnL += income
+	default:
+		return false
+	}
+
+	return true
+}
+
+// holdsOnMargin reports whether a margin account has a position in a
+// symbol. The caller must hold ledger.mu.
+func (ledger *Ledger) holdsOnMargin(symbol string) bool {
+	for _, holder := range ledger.accounts {
+		if position, exists := holder.positions[symbol]; exists && holder.Margin && position.Quantity != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ledger *Ledger) wakeMarginChecks() {
+	select {
+	case ledger.marginChecks <- struct{}{}:
+	default:
+	}
+}
+
+func (ledger *Ledger) publish(update models.AccountUpdate) {
+	for key, subscriber := range ledger.subscribers {
+		if subscriber.account != update.Account.ID {
+			continue
+		}
+
+		select {
+		case subscriber.updates <- update:
+		default:
+			log.Printf("[Accounts] Subscriber %d of %s is not keeping up, dropped an update", key, update.Account.ID)
+		}
+	}
+}
+
+func (ledger *Ledger) Snapshot() []models.Account {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	accounts := make([]models.Account, 0, len(ledger.accounts))
+	for _, id := range slices.Sorted(maps.Keys(ledger.accounts)) {
+		accounts = append(accounts, ledger.accounts[id].snapshot())
+	}
+
+	return accounts
+}
+
+// Restore replaces the accounts and rebuilds what their open orders hold
+// from the restored book.
+func (ledger *Ledger) Restore(accounts []models.Account, orders []models.Order) {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	ledger.accounts = make(map[string]*account, len(accounts))
+	ledger.orders = make(map[string]*reservation)
+
+	for _, restored := range accounts {
+		entry := &account{Account: restored, positions: make(map[string]*models.Position)}
+		entry.Positions = nil
+		entry.Reserved = 0
+		for _, position := range restored.Positions {
+			position.Reserved = 0
+			entry.positions[position.Symbol] = &position
+		}
+		ledger.accounts[restored.ID] = entry
+	}
+
+	for _, order := range orders {
+		if owner, exists := ledger.accounts[order.Owner]; exists && order.IsOpen() {
+			ledger.reserve(owner, order)
+		}
+	}
+}
+
+// position returns the account's position in a symbol, adding an empty one
+// if there is none.
+func (account *account) position(symbol string) *models.Position {
+	position, exists := account.positions[symbol]
+	if !exists {
+		position = &models.Position{Symbol: symbol}
+		account.positions[symbol] = position
+	}
+
+	return position
+}
+
+func (account *account) snapshot() models.Account {
+	snapshot := account.Account
+	snapshot.Unsettled = slices.Clone(account.Unsettled)
+	snapshot.Positions = make([]models.Position, 0, len(account.positions))
+	for _, symbol := range slices.Sorted(maps.Keys(account.positions)) {
+		snapshot.Positions = append(snapshot.Positions, *account.positions[symbol])
+	}
+
+	return snapshot
+}
+
+func newAccountID() (string, error) {
+	var id [6]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+
+	return "ACC-" + hex.EncodeToString(id[:]), nil
+}
+
+var _ marketengine.Ledger = (*Ledger)(nil)
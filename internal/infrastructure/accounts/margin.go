@@ -0,0 +1,357 @@
+package accounts
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/models"
+	"math"
+	"os"
+	"slices"
+	"time"
+)
+
+// MarginSettings set how far margin accounts may borrow. Ratios are equity
+// as a fraction of the value of positions in marginable symbols, long or
+// short; positions in other symbols must be paid for in full.
+type MarginSettings struct {
+	// InitialRatio is the equity new positions need.
+	InitialRatio float64 `json:"initial_ratio"`
+	// MaintenanceRatio is the equity below which an account is called.
+	MaintenanceRatio float64 `json:"maintenance_ratio"`
+	// LiquidationRatio is the equity below which positions are liquidated
+	// without waiting for the call's deadline. With zero, only an account
+	// whose equity turns negative is liquidated before the deadline.
+	LiquidationRatio float64 `json:"liquidation_ratio"`
+	// CallGraceMs is how long, on the engine clock, a margin call gives an
+	// account to pay in cash or reduce its positions.
+	CallGraceMs int `json:"call_grace_ms"`
+	// ShortSelling lets margin accounts sell marginable symbols they do not
+	// hold, borrowing the shares.
+	ShortSelling bool `json:"short_selling"`
+	// Marginable are the symbols that can be bought on margin and sold
+	// short.
+	Marginable []string `json:"marginable"`
+	// CheckIntervalMs is how often accounts are checked when no price they
+	// hold moves, so calls whose deadline passes are acted on.
+	CheckIntervalMs int `json:"check_interval_ms"`
+}
+
+// DefaultMarginSettings follow the OJK margin rules: 50% initial margin,
+// a call below 35% and forced sale below 25%, on a list of liquid large
+// caps that may also be sold short.
+func DefaultMarginSettings() MarginSettings {
+	return MarginSettings{
+		InitialRatio:     0.5,
+		MaintenanceRatio: 0.35,
+		LiquidationRatio: 0.25,
+		CallGraceMs:      30 * 60 * 1000,
+		ShortSelling:     true,
+		Marginable: []string{
+			"AADI", "ADRO", "ANTM", "ASII", "BBCA", "BBNI", "BBRI", "BMRI", "ICBP", "INDF",
+			"ISAT", "ITMG", "KLBF", "MDKA", "PTBA", "TLKM", "UNTR", "UNVR",
+		},
+		CheckIntervalMs: 1000,
+	}
+}
+
+// LoadMarginSettings reads a JSON settings file. Fields missing from the
+// file keep their default values; a marginable list replaces the default
+// one.
+func LoadMarginSettings(path string) (MarginSettings, error) {
+	settings := DefaultMarginSettings()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, err
+	}
+
+	if !(0 < settings.MaintenanceRatio && settings.MaintenanceRatio <= settings.InitialRatio && settings.InitialRatio <= 1) {
+		return settings, fmt.Errorf("margin ratios must satisfy 0 < maintenance_ratio <= initial_ratio <= 1")
+	}
+	if settings.LiquidationRatio < 0 || settings.LiquidationRatio > settings.MaintenanceRatio {
+		return settings, fmt.Errorf("liquidation_ratio must be between 0 and maintenance_ratio")
+	}
+
+	return settings, nil
+}
+
+// IsMarginable reports whether a symbol can be bought on margin.
+func (settings MarginSettings) IsMarginable(symbol string) bool {
+	return slices.Contains(settings.Marginable, symbol)
+}
+
+// rate is the share of a position's value equity must cover at a ratio.
+func (settings MarginSettings) rate(symbol string, ratio float64) float64 {
+	if settings.IsMarginable(symbol) {
+		return ratio
+	}
+
+	return 1
+}
+
+func (settings MarginSettings) callGrace() time.Duration {
+	return time.Duration(settings.CallGraceMs) * time.Millisecond
+}
+
+func (settings MarginSettings) checkInterval() time.Duration {
+	return time.Duration(max(settings.CheckIntervalMs, 1)) * time.Millisecond
+}
+
+// prices are what margin is valued at: the engine's last prices, or the
+// view of them a check under the engine lock is given.
+type prices interface {
+	LastPrice(symbol string) (float64, bool)
+}
+
+// MarginStatus values a margin account at last prices.
+func (ledger *Ledger) MarginStatus(account models.Account) models.MarginStatus {
+	return ledger.marginStatus(account, ledger.engine)
+}
+
+func (ledger *Ledger) marginStatus(account models.Account, market prices) models.MarginStatus {
+	status := models.MarginStatus{Equity: account.Cash}
+	for _, position := range account.Positions {
+		if position.Quantity == 0 {
+			continue
+		}
+
+		price, exists := market.LastPrice(position.Symbol)
+		if !exists {
+			price = position.AverageCost
+		}
+
+		value := price * float64(position.Quantity)
+		status.Equity += value
+		if value > 0 {
+			status.LongValue += value
+		} else {
+			status.ShortValue -= value
+		}
+
+		gross := math.Abs(value)
+		status.InitialRequirement += gross * ledger.Margin.rate(position.Symbol, ledger.Margin.InitialRatio)
+		status.MaintenanceRequirement += gross * ledger.Margin.rate(position.Symbol, ledger.Margin.MaintenanceRatio)
+		status.LiquidationRequirement += gross * ledger.Margin.rate(position.Symbol, ledger.Margin.LiquidationRatio)
+	}
+	status.Excess = status.Equity - status.InitialRequirement - account.Reserved
+
+	return status
+}
+
+// marginBuyingPower is the value of marginable stock a margin account can
+// add to its positions.
+func (ledger *Ledger) marginBuyingPower(account models.Account, market prices) float64 {
+	return max(ledger.marginStatus(account, market).Excess, 0) / ledger.Margin.InitialRatio
+}
+
+// checkMarginOrder holds the part of an order that adds to a position, a
+// buy or a sale beyond the shares held, which sells short, to the initial
+// requirement. While the account is in a margin call only orders that
+// reduce its positions are accepted. The caller must hold ledger.mu.
+func (ledger *Ledger) checkMarginOrder(owner *account, order models.Order, market marketengine.MarketView) error {
+	opening := order.Quantity - owner.closing(order.Ticker, order.Side, order.Quantity)
+	if opening == 0 {
+		return nil
+	}
+
+	if owner.MarginCall != nil {
+		return fmt.Errorf("%w: only orders that reduce positions are accepted until it is met", ErrMarginCall)
+	}
+
+	if order.Side == models.SideSell && !(ledger.Margin.ShortSelling && ledger.Margin.IsMarginable(order.Ticker)) {
+		return fmt.Errorf("%w: selling %d %s, %d available, and it cannot be sold short",
+			ErrInsufficientShares, order.Quantity, order.Ticker, order.Quantity-opening)
+	}
+
+	value := market.Cost(order) * float64(opening) / float64(order.Quantity)
+	required := value * ledger.holdRate(owner, order.Ticker, order.Side)
+	excess := ledger.marginStatus(owner.snapshot(), market).Excess
+	if required > excess {
+		return fmt.Errorf("%w: order needs %.0f of margin with fees, %.0f excess equity", ErrInsufficientBuyingPower, required, excess)
+	}
+
+	return nil
+}
+
+// RunMarginCalls checks margin accounts whenever a price they hold moves or
+// a called account is funded, and at the check interval, until ctx ends.
+func (ledger *Ledger) RunMarginCalls(ctx context.Context) {
+	ticker := time.NewTicker(ledger.Margin.checkInterval())
+	defer ticker.Stop()
+
+	for {
+		ledger.CheckMargins()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ledger.marginChecks:
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckMargins values every margin account at last prices. An account below
+// the maintenance requirement is called and one back above it has its call
+// met. One below the liquidation requirement, or still called when the
+// call's deadline passes, has positions closed through the book until it is
+// back at the initial requirement.
+func (ledger *Ledger) CheckMargins() {
+	now := ledger.engine.Now()
+
+	for _, account := range ledger.marginAccounts() {
+		status := ledger.MarginStatus(account)
+
+		if status.Equity >= status.MaintenanceRequirement {
+			if account.MarginCall != nil && ledger.recordMarginCall(models.EventMarginCallMet, account.ID, nil) {
+				log.Printf("[Margin] %s met its margin call with equity %.0f", account.ID, status.Equity)
+			}
+			continue
+		}
+
+		if account.MarginCall == nil {
+			call := &models.MarginCall{
+				IssuedAt:    now,
+				Deadline:    now.Add(ledger.Margin.callGrace()),
+				Equity:      status.Equity,
+				Requirement: status.MaintenanceRequirement,
+			}
+			if !ledger.recordMarginCall(models.EventMarginCall, account.ID, call) {
+				continue
+			}
+			account.MarginCall = call
+			log.Printf("[Margin] Called %s: equity %.0f is below the maintenance requirement of %.0f", account.ID, status.Equity, status.MaintenanceRequirement)
+		}
+
+		if status.Equity < status.LiquidationRequirement || !now.Before(account.MarginCall.Deadline) {
+			ledger.liquidate(account, status)
+		}
+	}
+}
+
+// marginAccounts returns the margin accounts with positions or a call.
+func (ledger *Ledger) marginAccounts() []models.Account {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	var margined []models.Account
+	for _, account := range ledger.accounts {
+		if account.Margin && (account.MarginCall != nil || account.holdsAny()) {
+			margined = append(margined, account.snapshot())
+		}
+	}
+
+	return margined
+}
+
+func (ledger *Ledger) recordMarginCall(eventType string, accountID string, call *models.MarginCall) bool {
+	_, err := ledger.engine.RecordEvent(models.Event{
+		Type:       eventType,
+		Account:    &models.AccountChange{ID: accountID},
+		MarginCall: call,
+	})
+	if err != nil {
+		log.Printf("[Margin] Failed to record %s for %s: %v", eventType, accountID, err)
+		return false
+	}
+
+	return true
+}
+
+// liquidate cancels the account's open orders and closes positions with
+// market orders, largest first, until the requirement they free brings
+// equity back up to the initial requirement. With no equity left every
+// position is closed.
+func (ledger *Ledger) liquidate(account models.Account, status models.MarginStatus) {
+	ledger.engine.CancelOwned(account.ID)
+
+	type holding struct {
+		position models.Position
+		price    float64
+	}
+	var holdings []holding
+	for _, position := range account.Positions {
+		if price, exists := ledger.engine.LastPrice(position.Symbol); exists && price > 0 && position.Quantity != 0 {
+			holdings = append(holdings, holding{position: position, price: price})
+		}
+	}
+	slices.SortFunc(holdings, func(a holding, b holding) int {
+		return cmp.Compare(math.Abs(b.price*float64(b.position.Quantity)), math.Abs(a.price*float64(a.position.Quantity)))
+	})
+
+	shortfall := status.InitialRequirement - status.Equity
+	for _, held := range holdings {
+		if shortfall <= 0 {
+			break
+		}
+
+		rate := ledger.Margin.rate(held.position.Symbol, ledger.Margin.InitialRatio)
+		lots := abs(held.position.Quantity) / models.LotSize
+		if status.Equity > 0 {
+			lots = min(lots, int(math.Ceil(shortfall/rate/held.price/models.LotSize)))
+		}
+		if lots == 0 {
+			continue
+		}
+
+		side := models.SideSell
+		if held.position.Quantity < 0 {
+			side = models.SideBuy
+		}
+
+		order, _, err := ledger.engine.SubmitOrder(models.Order{
+			Owner:       account.ID,
+			Ticker:      held.position.Symbol,
+			Side:        side,
+			Type:        models.OrderTypeMarket,
+			Quantity:    lots * models.LotSize,
+			Liquidation: true,
+		})
+		if err != nil {
+			log.Printf("[Margin] Failed to liquidate %s of %s: %v", held.position.Symbol, account.ID, err)
+			continue
+		}
+
+		log.Printf("[Margin] Liquidated %s: %s %d of %d %s", account.ID, side, order.Filled, order.Quantity, held.position.Symbol)
+		shortfall -= float64(order.Filled) * held.price * rate
+	}
+}
+
+// holdsAny reports whether the account has an open position.
+func (account *account) holdsAny() bool {
+	for _, position := range account.positions {
+		if position.Quantity != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// closing is how much of an order of quantity shares closes a position
+// rather than adding to one: the shares held that are free to sell, or, on
+// a margin account, the short shares a buy covers.
+func (account *account) closing(symbol string, side string, quantity int) int {
+	held := models.Position{}
+	if position, exists := account.positions[symbol]; exists {
+		held = *position
+	}
+
+	if side == models.SideSell {
+		return min(quantity, held.Available())
+	}
+
+	return min(quantity, max(-held.Quantity, 0))
+}
+
+func abs(quantity int) int {
+	return max(quantity, -quantity)
+}
@@ -0,0 +1,157 @@
+package accounts
+
+import (
+	"context"
+	"log"
+	"market-engine-go/internal/infrastructure/calendar"
+	"market-engine-go/internal/models"
+	"slices"
+	"time"
+)
+
+type SettlementSettings struct {
+	// Days is the settlement cycle in trading days after the trade date.
+	Days int
+	// SpendUnsettled lets sale proceeds pay for buys before they settle.
+	// They can never be withdrawn before they settle.
+	SpendUnsettled bool
+	// Close is the time of day in WIB the settlement run starts after.
+	Close time.Duration
+	// Interval is how often the engine clock is checked for a due run.
+	Interval time.Duration
+}
+
+// DefaultSettlementSettings settles T+2 after the 16:00 close, the IDX
+// cycle, and lets unsettled proceeds be spent as IDX brokers do.
+func DefaultSettlementSettings() SettlementSettings {
+	return SettlementSettings{
+		Days:           2,
+		SpendUnsettled: true,
+		Close:          16 * time.Hour,
+		Interval:       time.Minute,
+	}
+}
+
+// RunSettlement settles due fills and expires day orders now and then at
+// the end of every trading day on the engine clock, until ctx ends.
+func (ledger *Ledger) RunSettlement(ctx context.Context) {
+	ticker := time.NewTicker(ledger.Settlement.Interval)
+	defer ticker.Stop()
+
+	for {
+		ledger.ExpireDayOrders()
+		ledger.Settle()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Settle settles every fill due by the last trading day that has closed on
+// the engine clock, and returns how many it settled.
+func (ledger *Ledger) Settle() int {
+	now := ledger.engine.Now()
+	date := calendar.Day(now)
+	if !ledger.Calendar.IsTradingDay(date) || now.Before(date.Add(ledger.Settlement.Close)) {
+		// Settlement dates are trading days, so settling through the day
+		// before settles through the last trading day that has closed.
+		date = date.AddDate(0, 0, -1)
+	}
+
+	due := ledger.due(date)
+	if due == 0 {
+		return 0
+	}
+
+	_, err := ledger.engine.RecordEvent(models.Event{Type: models.EventSettlement, SettlementDate: date})
+	if err != nil {
+		log.Printf("[Accounts] Failed to record settlement for %s: %v", date.Format(time.DateOnly), err)
+		return 0
+	}
+
+	log.Printf("[Accounts] Settled %d fills due by %s", due, date.Format(time.DateOnly))
+	return due
+}
+
+// ExpireDayOrders expires day orders whose session has closed on the engine
+// clock, and returns how many it expired.
+func (ledger *Ledger) ExpireDayOrders() int {
+	now := ledger.engine.Now()
+	expired := ledger.engine.ExpireOrders(func(order models.Order) bool {
+		return order.TimeInForce == models.TimeInForceDay && !now.Before(ledger.sessionClose(order.CreatedAt))
+	})
+
+	if expired > 0 {
+		log.Printf("[Accounts] Expired %d day orders", expired)
+	}
+	return expired
+}
+
+// sessionClose is the close of the session an order placed at t is good
+// for: that day's, on a trading day before the close, and otherwise the
+// next trading day's.
+func (ledger *Ledger) sessionClose(t time.Time) time.Time {
+	day := calendar.Day(t)
+	if !ledger.Calendar.IsTradingDay(day) || !t.Before(day.Add(ledger.Settlement.Close)) {
+		day = ledger.Calendar.NextTradingDay(day)
+	}
+
+	return day.Add(ledger.Settlement.Close)
+}
+
+// due counts the unsettled fills with a settlement date on or before date.
+func (ledger *Ledger) due(date time.Time) int {
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	due := 0
+	for _, account := range ledger.accounts {
+		for _, settlement := range account.Unsettled {
+			if !settlement.SettlementDate.After(date) {
+				due++
+			}
+		}
+	}
+
+	return due
+}
+
+// TradeDate is the trading day a fill at t counts for: its own day, or the
+// next trading day for fills on weekends and holidays.
+func (ledger *Ledger) TradeDate(t time.Time) time.Time {
+	day := calendar.Day(t)
+	if ledger.Calendar.IsTradingDay(day) {
+		return day
+	}
+
+	return ledger.Calendar.NextTradingDay(day)
+}
+
+func (ledger *Ledger) settlementDate(tradeDate time.Time) time.Time {
+	date := tradeDate
+	for range ledger.Settlement.Days {
+		date = ledger.Calendar.NextTradingDay(date)
+	}
+
+	return date
+}
+
+// settle drops the account's fills due on or before date and returns the
+// symbols they were in.
+func (account *account) settle(date time.Time) []string {
+	var symbols []string
+	account.Unsettled = slices.DeleteFunc(account.Unsettled, func(settlement models.Settlement) bool {
+		if settlement.SettlementDate.After(date) {
+			return false
+		}
+		if !slices.Contains(symbols, settlement.Symbol) {
+			symbols = append(symbols, settlement.Symbol)
+		}
+		return true
+	})
+
+	return symbols
+}
@@ -0,0 +1,178 @@
+package agents
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Population holds the settings shared by every agent type.
+type Population struct {
+	// Count is the number of agents of this type.
+	Count int `json:"count"`
+	// IntervalMs is the mean time between two actions of one agent.
+	IntervalMs int `json:"interval_ms"`
+	MinLots    int `json:"min_lots"`
+	MaxLots    int `json:"max_lots"`
+	// OrderTTLMs cancels resting limit orders older than this; zero keeps
+	// them until they fill.
+	OrderTTLMs int `json:"order_ttl_ms"`
+}
+
+type NoiseConfig struct {
+	Population
+	// LimitProbability is the share of orders sent as limit orders; the rest
+	// are market orders.
+	LimitProbability float64 `json:"limit_probability"`
+	// PriceRangeTicks bounds how far from the last price limit orders land.
+	PriceRangeTicks int `json:"price_range_ticks"`
+}
+
+type MomentumConfig struct {
+	Population
+	// Threshold is the relative gap between the fast and slow price averages
+	// that triggers a trade in the direction of the trend.
+	Threshold float64 `json:"threshold"`
+}
+
+type ValueConfig struct {
+	Population
+	// Threshold is the relative mispricing against the agent's estimate of
+	// the fundamental that triggers a trade.
+	Threshold float64 `json:"threshold"`
+	// EstimateNoise is the standard deviation of each agent's private error
+	// when estimating the fundamental.
+	EstimateNoise float64 `json:"estimate_noise"`
+}
+
+type LiquidityTakerConfig struct {
+	Population
+}
+
+type Settings struct {
+	Enabled bool `json:"enabled"`
+	// Symbols restricts the simulation to a subset of the loaded symbols.
+	// When empty every symbol is traded.
+	Symbols []string `json:"symbols"`
+	// FastAlpha and SlowAlpha are the smoothing factors of the per-symbol
+	// price averages that momentum traders compare.
+	FastAlpha       float64              `json:"fast_alpha"`
+	SlowAlpha       float64              `json:"slow_alpha"`
+	Noise           NoiseConfig          `json:"noise"`
+	Momentum        MomentumConfig       `json:"momentum"`
+	Value           ValueConfig          `json:"value"`
+	LiquidityTakers LiquidityTakerConfig `json:"liquidity_takers"`
+}
+
+func DefaultSettings() Settings {
+	return Settings{
+		Enabled:   true,
+		FastAlpha: 0.3,
+		SlowAlpha: 0.05,
+		Noise: NoiseConfig{
+			Population:       Population{Count: 200, IntervalMs: 1000, MinLots: 1, MaxLots: 50, OrderTTLMs: 30000},
+			LimitProbability: 0.7,
+			PriceRangeTicks:  5,
+		},
+		Momentum: MomentumConfig{
+			Population: Population{Count: 50, IntervalMs: 2000, MinLots: 10, MaxLots: 100, OrderTTLMs: 10000},
+			Threshold:  0.002,
+		},
+		Value: ValueConfig{
+			Population:    Population{Count: 50, IntervalMs: 3000, MinLots: 10, MaxLots: 200, OrderTTLMs: 60000},
+			Threshold:     0.01,
+			EstimateNoise: 0.01,
+		},
+		LiquidityTakers: LiquidityTakerConfig{
+			Population: Population{Count: 10, IntervalMs: 10000, MinLots: 100, MaxLots: 500},
+		},
+	}
+}
+
+// LoadSettings reads a JSON settings file. Fields missing from the file keep
+// their default values.
+func LoadSettings(path string) (Settings, error) {
+	settings := DefaultSettings()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, err
+	}
+
+	if err := settings.validate(); err != nil {
+		return settings, err
+	}
+
+	return settings, nil
+}
+
+func (settings Settings) validate() error {
+	for _, alpha := range []struct {
+		name  string
+		value float64
+	}{{"fast_alpha", settings.FastAlpha}, {"slow_alpha", settings.SlowAlpha}} {
+		if !(alpha.value > 0 && alpha.value <= 1) {
+			return fmt.Errorf("%s must be above 0 and at most 1", alpha.name)
+		}
+	}
+
+	populations := []struct {
+		name       string
+		population Population
+	}{
+		{"noise", settings.Noise.Population},
+		{"momentum", settings.Momentum.Population},
+		{"value", settings.Value.Population},
+		{"liquidity_takers", settings.LiquidityTakers.Population},
+	}
+	for _, entry := range populations {
+		if err := entry.population.validate(); err != nil {
+			return fmt.Errorf("%s: %w", entry.name, err)
+		}
+	}
+
+	switch {
+	case !(settings.Noise.LimitProbability >= 0 && settings.Noise.LimitProbability <= 1):
+		return fmt.Errorf("noise: limit_probability must be between 0 and 1")
+	case settings.Noise.PriceRangeTicks < 0:
+		return fmt.Errorf("noise: price_range_ticks must not be negative")
+	case !(settings.Momentum.Threshold >= 0):
+		return fmt.Errorf("momentum: threshold must not be negative")
+	case !(settings.Value.Threshold >= 0):
+		return fmt.Errorf("value: threshold must not be negative")
+	case !(settings.Value.EstimateNoise >= 0):
+		return fmt.Errorf("value: estimate_noise must not be negative")
+	}
+
+	return nil
+}
+
+func (population Population) validate() error {
+	switch {
+	case population.Count < 0:
+		return fmt.Errorf("count must not be negative")
+	case population.IntervalMs <= 0:
+		return fmt.Errorf("interval_ms must be positive")
+	case population.MinLots <= 0:
+		return fmt.Errorf("min_lots must be positive")
+	case population.MaxLots < population.MinLots:
+		return fmt.Errorf("max_lots must be at least min_lots")
+	case population.OrderTTLMs < 0:
+		return fmt.Errorf("order_ttl_ms must not be negative")
+	}
+
+	return nil
+}
+
+func (population Population) interval() time.Duration {
+	return time.Duration(population.IntervalMs) * time.Millisecond
+}
+
+func (population Population) orderTTL() time.Duration {
+	return time.Duration(population.OrderTTLMs) * time.Millisecond
+}
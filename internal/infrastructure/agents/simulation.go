@@ -0,0 +1,269 @@
+package agents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/models"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Simulation runs populations of simulated participants that submit orders
+// through the engine's order path, so prices emerge from their order flow.
+type Simulation struct {
+	engine   *marketengine.MarketEngine
+	settings Settings
+	trends   *trendTracker
+
+	mu      sync.RWMutex
+	symbols []string
+}
+
+type agent struct {
+	owner      string
+	trader     Trader
+	population Population
+	open       []restingOrder
+}
+
+type restingOrder struct {
+	id       string
+	placedAt time.Time
+}
+
+func NewSimulation(engine *marketengine.MarketEngine, settings Settings) *Simulation {
+	return &Simulation{
+		engine:   engine,
+		settings: settings,
+		trends:   newTrendTracker(settings.FastAlpha, settings.SlowAlpha),
+	}
+}
+
+func (simulation *Simulation) Start(ctx context.Context) {
+	if !simulation.settings.Enabled {
+		log.Println("[Agents] Disabled")
+		return
+	}
+
+	universe, unsubscribe := simulation.engine.SubscribeUniverse()
+
+	simulation.symbols = slices.Clone(simulation.settings.Symbols)
+	if len(simulation.symbols) == 0 {
+		simulation.symbols = simulation.engine.Symbols()
+	}
+
+	simulation.engine.AddTradeListener(simulation.trends.observe)
+
+	settings := simulation.settings
+	simulation.spawn(ctx, settings.Noise.Population, func() Trader { return NewNoiseTrader(settings.Noise) })
+	simulation.spawn(ctx, settings.Momentum.Population, func() Trader { return NewMomentumTrader(settings.Momentum) })
+	simulation.spawn(ctx, settings.Value.Population, func() Trader { return NewValueTrader(settings.Value) })
+	simulation.spawn(ctx, settings.LiquidityTakers.Population, func() Trader { return NewLiquidityTaker(settings.LiquidityTakers) })
+
+	log.Printf("[Agents] Trading %d symbols with %d noise, %d momentum, %d value and %d liquidity-taking agents",
+		len(simulation.symbols), settings.Noise.Count, settings.Momentum.Count, settings.Value.Count, settings.LiquidityTakers.Count)
+
+	go simulation.followUniverse(ctx, universe, unsubscribe)
+}
+
+// followUniverse drops delisted symbols from the traded set and, unless the
+// settings fix the symbols, adds newly listed ones.
+func (simulation *Simulation) followUniverse(ctx context.Context, universe <-chan marketengine.UniverseChange, unsubscribe func()) {
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change := <-universe:
+			simulation.mu.Lock()
+			simulation.symbols = slices.DeleteFunc(simulation.symbols, func(symbol string) bool {
+				return slices.Contains(change.Delisted, symbol)
+			})
+			if len(simulation.settings.Symbols) == 0 {
+				for _, data := range change.Listed {
+					simulation.symbols = append(simulation.symbols, data.Symbol)
+				}
+			}
+			simulation.mu.Unlock()
+		}
+	}
+}
+
+func (simulation *Simulation) spawn(ctx context.Context, population Population, newTrader func() Trader) {
+	if population.IntervalMs <= 0 {
+		return
+	}
+
+	for i := range population.Count {
+		trader := newTrader()
+		go simulation.run(ctx, &agent{
+			owner:      fmt.Sprintf("%s-%d", trader.Kind(), i+1),
+			trader:     trader,
+			population: population,
+		})
+	}
+}
+
+func (simulation *Simulation) run(ctx context.Context, agent *agent) {
+	// Orders recovered from the journal belong to a previous run.
+	simulation.engine.CancelOwned(agent.owner)
+	defer simulation.cancelAll(agent)
+
+	for {
+		// Exponential waits make each agent's actions a Poisson process.
+		wait := time.Duration(rand.ExpFloat64() * float64(agent.population.interval()))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+			simulation.expire(agent)
+			simulation.act(agent)
+		}
+	}
+}
+
+func (simulation *Simulation) act(agent *agent) {
+	simulation.mu.RLock()
+	if len(simulation.symbols) == 0 {
+		simulation.mu.RUnlock()
+		return
+	}
+
+	symbol := simulation.symbols[rand.IntN(len(simulation.symbols))]
+	simulation.mu.RUnlock()
+
+	view, ok := simulation.view(symbol)
+	if !ok {
+		return
+	}
+
+	for _, order := range agent.trader.Decide(view) {
+		order.Owner = agent.owner
+
+		placed, _, err := simulation.engine.SubmitOrder(order)
+		if err != nil {
+			continue
+		}
+
+		if placed.IsOpen() {
+			agent.open = append(agent.open, restingOrder{id: placed.ID, placedAt: placed.CreatedAt})
+		}
+	}
+}
+
+func (simulation *Simulation) view(symbol string) (View, bool) {
+	lastPrice, exists := simulation.engine.LastPrice(symbol)
+	if !exists {
+		return View{}, false
+	}
+
+	fundamental, _ := simulation.engine.Fundamental(symbol)
+	view := View{
+		Symbol:      symbol,
+		LastPrice:   lastPrice,
+		Fundamental: fundamental,
+		Trend:       simulation.trends.trend(symbol),
+		Volatility:  simulation.engine.Volatility(symbol),
+	}
+
+	if book, err := simulation.engine.OrderBook(symbol, 1); err == nil {
+		if len(book.Bids) > 0 {
+			view.BestBid = book.Bids[0].Price
+		}
+		if len(book.Asks) > 0 {
+			view.BestAsk = book.Asks[0].Price
+		}
+	}
+
+	return view, true
+}
+
+func (simulation *Simulation) expire(agent *agent) {
+	ttl := agent.population.orderTTL()
+	if ttl <= 0 {
+		return
+	}
+
+	now := simulation.engine.Now()
+	open := agent.open[:0]
+	for _, order := range agent.open {
+		if now.Sub(order.placedAt) < ttl {
+			open = append(open, order)
+			continue
+		}
+
+		simulation.cancel(order.id)
+	}
+
+	agent.open = open
+}
+
+func (simulation *Simulation) cancelAll(agent *agent) {
+	for _, order := range agent.open {
+		simulation.cancel(order.id)
+	}
+
+	agent.open = nil
+}
+
+func (simulation *Simulation) cancel(orderID string) {
+	_, err := simulation.engine.CancelOrder(orderID)
+	if err != nil && !errors.Is(err, marketengine.ErrOrderNotFound) {
+		log.Printf("[Agents] Cancel %s failed: %v", orderID, err)
+	}
+}
+
+// trendTracker keeps fast and slow exponential averages of each symbol's
+// trade prices for momentum traders.
+type trendTracker struct {
+	mu        sync.Mutex
+	fastAlpha float64
+	slowAlpha float64
+	averages  map[string]*priceAverages
+}
+
+type priceAverages struct {
+	fast float64
+	slow float64
+}
+
+func newTrendTracker(fastAlpha float64, slowAlpha float64) *trendTracker {
+	return &trendTracker{
+		fastAlpha: fastAlpha,
+		slowAlpha: slowAlpha,
+		averages:  make(map[string]*priceAverages),
+	}
+}
+
+func (tracker *trendTracker) observe(trade models.Trade) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	averages, exists := tracker.averages[trade.Ticker]
+	if !exists {
+		tracker.averages[trade.Ticker] = &priceAverages{fast: trade.Price, slow: trade.Price}
+		return
+	}
+
+	averages.fast += tracker.fastAlpha * (trade.Price - averages.fast)
+	averages.slow += tracker.slowAlpha * (trade.Price - averages.slow)
+}
+
+func (tracker *trendTracker) trend(symbol string) float64 {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	averages, exists := tracker.averages[symbol]
+	if !exists || averages.slow == 0 {
+		return 0
+	}
+
+	return (averages.fast - averages.slow) / averages.slow
+}
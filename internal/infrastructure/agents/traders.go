@@ -0,0 +1,188 @@
+package agents
+
+import (
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/models"
+	"math"
+	"math/rand/v2"
+)
+
+// View is what an agent sees of one symbol when it wakes up.
+type View struct {
+	Symbol      string
+	LastPrice   float64
+	Fundamental float64
+	// BestBid and BestAsk are zero when that side of the book is empty.
+	BestBid float64
+	BestAsk float64
+	// Trend is the relative gap between the fast and slow price averages.
+	Trend float64
+	// Volatility scales how far from the last price agents are willing to
+	// trade. It is 1 in normal conditions.
+	Volatility float64
+}
+
+// Trader decides which orders, if any, a simulated participant submits when
+// it wakes up. The simulation fills in the owner before submitting them.
+type Trader interface {
+	Kind() string
+	Decide(view View) []models.Order
+}
+
+type NoiseTrader struct {
+	config NoiseConfig
+}
+
+func NewNoiseTrader(config NoiseConfig) *NoiseTrader {
+	return &NoiseTrader{config: config}
+}
+
+func (trader *NoiseTrader) Kind() string {
+	return "NOISE"
+}
+
+// Decide sends a random-side order, either at market or as a limit order a
+// few ticks around the last price.
+func (trader *NoiseTrader) Decide(view View) []models.Order {
+	side := randomSide()
+	quantity := randomQuantity(trader.config.Population)
+
+	if rand.Float64() >= trader.config.LimitProbability {
+		return []models.Order{marketOrder(view.Symbol, side, quantity)}
+	}
+
+	tick := marketengine.TickSize(view.LastPrice)
+	rangeTicks := int(math.Round(float64(trader.config.PriceRangeTicks) * view.Volatility))
+	// Mostly passive, occasionally one tick through the last price.
+	offset := float64(rand.IntN(rangeTicks+2)-1) * tick
+
+	price := marketengine.RoundUpToTick(view.LastPrice + offset)
+	if side == models.SideBuy {
+		price = marketengine.RoundDownToTick(view.LastPrice - offset)
+	}
+
+	if price <= 0 {
+		return nil
+	}
+
+	return []models.Order{limitOrder(view.Symbol, side, price, quantity)}
+}
+
+type MomentumTrader struct {
+	config MomentumConfig
+}
+
+func NewMomentumTrader(config MomentumConfig) *MomentumTrader {
+	return &MomentumTrader{config: config}
+}
+
+func (trader *MomentumTrader) Kind() string {
+	return "MOMENTUM"
+}
+
+// Decide buys into an uptrend and sells into a downtrend at market.
+func (trader *MomentumTrader) Decide(view View) []models.Order {
+	quantity := randomQuantity(trader.config.Population)
+
+	switch {
+	case view.Trend > trader.config.Threshold:
+		return []models.Order{marketOrder(view.Symbol, models.SideBuy, quantity)}
+	case view.Trend < -trader.config.Threshold:
+		return []models.Order{marketOrder(view.Symbol, models.SideSell, quantity)}
+	default:
+		return nil
+	}
+}
+
+type ValueTrader struct {
+	config ValueConfig
+	// bias is this agent's private, persistent error on the fundamental.
+	bias float64
+}
+
+func NewValueTrader(config ValueConfig) *ValueTrader {
+	return &ValueTrader{
+		config: config,
+		bias:   rand.NormFloat64() * config.EstimateNoise,
+	}
+}
+
+func (trader *ValueTrader) Kind() string {
+	return "VALUE"
+}
+
+// Decide posts a limit order at the last price whenever the market trades
+// far enough from the agent's estimate of the fundamental.
+func (trader *ValueTrader) Decide(view View) []models.Order {
+	estimate := view.Fundamental * (1 + trader.bias)
+	if estimate <= 0 {
+		return nil
+	}
+
+	mispricing := (estimate - view.LastPrice) / estimate
+	quantity := randomQuantity(trader.config.Population)
+
+	switch {
+	case mispricing > trader.config.Threshold:
+		price := marketengine.RoundDownToTick(view.LastPrice)
+		return []models.Order{limitOrder(view.Symbol, models.SideBuy, price, quantity)}
+	case mispricing < -trader.config.Threshold:
+		price := marketengine.RoundUpToTick(view.LastPrice)
+		return []models.Order{limitOrder(view.Symbol, models.SideSell, price, quantity)}
+	default:
+		return nil
+	}
+}
+
+type LiquidityTaker struct {
+	config LiquidityTakerConfig
+}
+
+func NewLiquidityTaker(config LiquidityTakerConfig) *LiquidityTaker {
+	return &LiquidityTaker{config: config}
+}
+
+func (trader *LiquidityTaker) Kind() string {
+	return "TAKER"
+}
+
+// Decide sweeps the book with a large market order on a random side.
+func (trader *LiquidityTaker) Decide(view View) []models.Order {
+	return []models.Order{marketOrder(view.Symbol, randomSide(), randomQuantity(trader.config.Population))}
+}
+
+func randomSide() string {
+	if rand.IntN(2) == 0 {
+		return models.SideBuy
+	}
+
+	return models.SideSell
+}
+
+func randomQuantity(population Population) int {
+	lots := population.MinLots
+	if population.MaxLots > population.MinLots {
+		lots += rand.IntN(population.MaxLots - population.MinLots + 1)
+	}
+
+	return max(lots, 1) * models.LotSize
+}
+
+func limitOrder(symbol string, side string, price float64, quantity int) models.Order {
+	return models.Order{
+		Ticker:   symbol,
+		Side:     side,
+		Type:     models.OrderTypeLimit,
+		Price:    price,
+		Quantity: quantity,
+	}
+}
+
+func marketOrder(symbol string, side string, quantity int) models.Order {
+	return models.Order{
+		Ticker:   symbol,
+		Side:     side,
+		Type:     models.OrderTypeMarket,
+		Quantity: quantity,
+	}
+}
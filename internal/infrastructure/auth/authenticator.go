@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	// ErrNoCredentials means a call carries no credentials an
+	// authenticator checks.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials means a call's credentials were checked and
+	// refused.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Authenticator proves who makes a call from its metadata. It returns
+// ErrNoCredentials when the metadata carries none of the kind it checks, so
+// another authenticator can be tried.
+type Authenticator interface {
+	Authenticate(md metadata.MD) (Identity, error)
+}
+
+// New returns the authenticators the settings configure, tried in turn.
+func New(settings Settings) (Authenticator, error) {
+	var chain Chain
+	if len(settings.APIKeys) > 0 {
+		chain = append(chain, NewAPIKeys(settings.APIKeys))
+	}
+	if settings.JWT != nil {
+		verifier, err := NewJWTVerifier(*settings.JWT)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, verifier)
+	}
+
+	return chain, nil
+}
+
+// Chain tries authenticators in turn. The first that finds credentials
+// decides.
+type Chain []Authenticator
+
+func (chain Chain) Authenticate(md metadata.MD) (Identity, error) {
+	for _, authenticator := range chain {
+		identity, err := authenticator.Authenticate(md)
+		if !errors.Is(err, ErrNoCredentials) {
+			return identity, err
+		}
+	}
+
+	return Identity{}, ErrNoCredentials
+}
+
+// APIKeys authenticate the key in the x-api-key header.
+type APIKeys struct {
+	keys map[[sha256.Size]byte]APIKey
+}
+
+// NewAPIKeys indexes keys by hash; their hashes must already be valid, as
+// LoadSettings checks.
+func NewAPIKeys(keys []APIKey) *APIKeys {
+	apiKeys := &APIKeys{keys: make(map[[sha256.Size]byte]APIKey, len(keys))}
+	for _, key := range keys {
+		var hash [sha256.Size]byte
+		hex.Decode(hash[:], []byte(key.KeySHA256))
+		apiKeys.keys[hash] = key
+	}
+
+	return apiKeys
+}
+
+func (apiKeys *APIKeys) Authenticate(md metadata.MD) (Identity, error) {
+	values := md.Get("x-api-key")
+	if len(values) == 0 {
+		return Identity{}, ErrNoCredentials
+	}
+
+	key, exists := apiKeys.keys[sha256.Sum256([]byte(values[0]))]
+	if !exists {
+		return Identity{}, fmt.Errorf("%w: unknown API key", ErrInvalidCredentials)
+	}
+
+	return Identity{Subject: key.Subject, Roles: key.Roles, Method: MethodAPIKey}, nil
+}
+
+// JWTVerifier authenticates the bearer token in the authorization header.
+// Tokens must be signed with the configured algorithm and key, carry a
+// subject and an expiry, and match the issuer and audience when set.
+type JWTVerifier struct {
+	settings JWTSettings
+	key      any
+	parser   *jwt.Parser
+}
+
+func NewJWTVerifier(settings JWTSettings) (*JWTVerifier, error) {
+	data, err := os.ReadFile(settings.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("jwt key: %w", err)
+	}
+
+	verifier := &JWTVerifier{settings: settings}
+	switch settings.Algorithm {
+	case AlgorithmHS256:
+		// A trailing newline left by an editor or echo is not part of the
+		// secret.
+		secret := bytes.TrimRight(data, "\r\n")
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("jwt key: an HS256 secret needs at least 32 bytes, %s has %d", settings.KeyFile, len(secret))
+		}
+		verifier.key = secret
+	case AlgorithmRS256:
+		if verifier.key, err = jwt.ParseRSAPublicKeyFromPEM(data); err != nil {
+			return nil, fmt.Errorf("jwt key: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("jwt: unsupported algorithm %q", settings.Algorithm)
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{settings.Algorithm}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Duration(settings.LeewayMs) * time.Millisecond),
+	}
+	if settings.Issuer != "" {
+		options = append(options, jwt.WithIssuer(settings.Issuer))
+	}
+	if settings.Audience != "" {
+		options = append(options, jwt.WithAudience(settings.Audience))
+	}
+	verifier.parser = jwt.NewParser(options...)
+
+	return verifier, nil
+}
+
+func (verifier *JWTVerifier) Authenticate(md metadata.MD) (Identity, error) {
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return Identity{}, ErrNoCredentials
+	}
+
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "bearer") {
+		return Identity{}, ErrNoCredentials
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := verifier.parser.ParseWithClaims(strings.TrimSpace(token), claims, func(*jwt.Token) (any, error) {
+		return verifier.key, nil
+	})
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return Identity{}, fmt.Errorf("%w: token has no subject", ErrInvalidCredentials)
+	}
+
+	return Identity{Subject: subject, Roles: roles(claims[verifier.settings.RolesClaim]), Method: MethodJWT}, nil
+}
+
+// roles reads a roles claim, a list of strings or a space-separated string
+// like an OAuth scope.
+func roles(claim any) []string {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		var roles []string
+		for _, role := range value {
+			if name, ok := role.(string); ok {
+				roles = append(roles, name)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Accounts tell the authorizer whose account a call acts on.
+type Accounts interface {
+	// Owner returns the subject who opened an account.
+	Owner(accountID string) (string, bool)
+	// OrderAccount returns the account an open order was placed for.
+	OrderAccount(orderID string) (string, bool)
+}
+
+// Authorizer enforces a policy on the identity the Interceptor put in a
+// call's context, so it must run after it. A call without an identity is to
+// a public method and is not checked. Denied calls fail with
+// PermissionDenied and are logged.
+type Authorizer struct {
+	policy   Policy
+	accounts Accounts
+}
+
+func NewAuthorizer(policy Policy, accounts Accounts) *Authorizer {
+	return &Authorizer{policy: policy, accounts: accounts}
+}
+
+func (authorizer *Authorizer) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	identity, ok := FromContext(ctx)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	switch authorizer.policy.access(info.FullMethod, identity) {
+	case denied:
+		return nil, authorizer.deny(ctx, info.FullMethod, identity, "none of the caller's roles may call it")
+	case ownAccounts:
+		if err := authorizer.checkOwner(ctx, info.FullMethod, identity, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (authorizer *Authorizer) Stream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	identity, ok := FromContext(ctx)
+	if !ok {
+		return handler(srv, stream)
+	}
+
+	switch authorizer.policy.access(info.FullMethod, identity) {
+	case denied:
+		return authorizer.deny(ctx, info.FullMethod, identity, "none of the caller's roles may call it")
+	case ownAccounts:
+		// The account is in the request, which the handler receives.
+		stream = &ownedStream{ServerStream: stream, check: func(req any) error {
+			return authorizer.checkOwner(ctx, info.FullMethod, identity, req)
+		}}
+	}
+
+	return handler(srv, stream)
+}
+
+// checkOwner allows a request that names an account the caller opened,
+// directly or through one of its open orders.
+func (authorizer *Authorizer) checkOwner(ctx context.Context, method string, identity Identity, req any) error {
+	var account string
+	if named, ok := req.(interface{ GetAccountId() string }); ok {
+		account = named.GetAccountId()
+	}
+	if account == "" {
+		if named, ok := req.(interface{ GetOrderId() string }); ok && named.GetOrderId() != "" {
+			var exists bool
+			if account, exists = authorizer.accounts.OrderAccount(named.GetOrderId()); !exists {
+				return authorizer.deny(ctx, method, identity, fmt.Sprintf("order %s is not an open order of an account", named.GetOrderId()))
+			}
+		}
+	}
+	if account == "" {
+		return authorizer.deny(ctx, method, identity, "the call names no account of the caller")
+	}
+
+	if owner, exists := authorizer.accounts.Owner(account); !exists || owner != identity.Subject {
+		return authorizer.deny(ctx, method, identity, fmt.Sprintf("account %s is not the caller's", account))
+	}
+
+	return nil
+}
+
+// deny logs a refused call for audit and returns its error.
+func (authorizer *Authorizer) deny(ctx context.Context, method string, identity Identity, reason string) error {
+	address := "unknown"
+	if caller, ok := peer.FromContext(ctx); ok {
+		address = caller.Addr.String()
+	}
+	log.Printf("[Auth] Denied %s to %s (roles %v, %s) from %s: %s", method, identity.Subject, identity.Roles, identity.Method, address, reason)
+
+	return status.Errorf(codes.PermissionDenied, "%s may not call %s: %s", identity.Subject, method, reason)
+}
+
+// ownedStream is a server stream whose request is checked when the handler
+// receives it.
+type ownedStream struct {
+	grpc.ServerStream
+	check func(req any) error
+}
+
+func (stream *ownedStream) RecvMsg(m any) error {
+	if err := stream.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return stream.check(m)
+}
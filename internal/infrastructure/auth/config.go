@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+)
+
+// APIKey is a key a client sends in the x-api-key header. Only its SHA-256
+// hash is kept, so the settings file holds no secret.
+type APIKey struct {
+	// Subject is who calls with the key.
+	Subject string `json:"subject"`
+	// KeySHA256 is the hex SHA-256 hash of the key.
+	KeySHA256 string   `json:"key_sha256"`
+	Roles     []string `json:"roles"`
+}
+
+// JWTSettings verify bearer tokens sent in the authorization header.
+type JWTSettings struct {
+	// Algorithm is HS256, with KeyFile holding the shared secret, or RS256,
+	// with KeyFile holding the issuer's PEM public key.
+	Algorithm string `json:"algorithm"`
+	KeyFile   string `json:"key_file"`
+	// Issuer and Audience, when set, must match the token's iss and aud.
+	Issuer   string `json:"issuer"`
+	Audience string `json:"audience"`
+	// RolesClaim names the claim listing the subject's roles, as an array
+	// or a space-separated string.
+	RolesClaim string `json:"roles_claim"`
+	// LeewayMs is the clock skew allowed when checking exp and nbf.
+	LeewayMs int `json:"leeway_ms"`
+}
+
+// Settings are how gRPC callers prove who they are. Every call must carry
+// an API key or a JWT unless its method is listed in PublicMethods.
+type Settings struct {
+	APIKeys []APIKey     `json:"api_keys"`
+	JWT     *JWTSettings `json:"jwt"`
+	// PublicMethods are full gRPC method names, such as
+	// /grpc.reflection.v1.ServerReflection/ServerReflectionInfo, callable
+	// without credentials.
+	PublicMethods []string `json:"public_methods"`
+}
+
+func DefaultSettings() Settings {
+	return Settings{}
+}
+
+// LoadSettings reads a JSON settings file. A jwt section's fields missing
+// from the file keep their default values.
+func LoadSettings(path string) (Settings, error) {
+	settings := DefaultSettings()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, err
+	}
+
+	for i, key := range settings.APIKeys {
+		if key.Subject == "" {
+			return settings, fmt.Errorf("api_keys[%d]: subject is required", i)
+		}
+		if hash, err := hex.DecodeString(key.KeySHA256); err != nil || len(hash) != 32 {
+			return settings, fmt.Errorf("api_keys[%d]: key_sha256 must be a hex SHA-256 hash", i)
+		}
+	}
+
+	if settings.JWT != nil {
+		if settings.JWT.RolesClaim == "" {
+			settings.JWT.RolesClaim = "roles"
+		}
+		if !slices.Contains([]string{AlgorithmHS256, AlgorithmRS256}, settings.JWT.Algorithm) {
+			return settings, fmt.Errorf("jwt: algorithm must be %s or %s", AlgorithmHS256, AlgorithmRS256)
+		}
+		if settings.JWT.KeyFile == "" {
+			return settings, fmt.Errorf("jwt: key_file is required")
+		}
+	}
+
+	if len(settings.APIKeys) == 0 && settings.JWT == nil {
+		return settings, fmt.Errorf("no api_keys or jwt: nobody could call the engine")
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	MethodAPIKey = "api_key"
+	MethodJWT    = "jwt"
+)
+
+// Identity is who made a gRPC call.
+type Identity struct {
+	Subject string
+	Roles   []string
+	// Method is how the caller proved it: api_key or jwt.
+	Method string
+}
+
+func (identity Identity) HasRole(role string) bool {
+	return slices.Contains(identity.Roles, role)
+}
+
+type identityKey struct{}
+
+// NewContext returns a context carrying the caller's identity.
+func NewContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the identity of the caller a handler serves; ok is
+// false when authentication is off or the method is public.
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Interceptor authenticates gRPC calls and puts the caller's Identity in
+// the context handlers get. Calls to public methods go through without
+// credentials; any others without valid ones fail with Unauthenticated.
+type Interceptor struct {
+	authenticator Authenticator
+	public        map[string]bool
+}
+
+func NewInterceptor(authenticator Authenticator, publicMethods []string) *Interceptor {
+	public := make(map[string]bool, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = true
+	}
+
+	return &Interceptor{authenticator: authenticator, public: public}
+}
+
+func (interceptor *Interceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := interceptor.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (interceptor *Interceptor) Stream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := interceptor.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &identifiedStream{ServerStream: stream, ctx: ctx})
+}
+
+func (interceptor *Interceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if interceptor.public[method] {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	identity, err := interceptor.authenticator.Authenticate(md)
+	if err != nil {
+		address := "unknown"
+		if caller, ok := peer.FromContext(ctx); ok {
+			address = caller.Addr.String()
+		}
+		log.Printf("[Auth] Refused %s from %s: %v", method, address, err)
+
+		if errors.Is(err, ErrNoCredentials) {
+			return ctx, status.Error(codes.Unauthenticated, "credentials required: send an x-api-key or an authorization bearer token")
+		}
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return NewContext(ctx, identity), nil
+}
+
+// identifiedStream is a server stream whose context carries the caller's
+// identity.
+type identifiedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *identifiedStream) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// Rule is who may call a gRPC method.
+type Rule struct {
+	// Roles may call the method on any account.
+	Roles []string `json:"roles"`
+	// OwnerRoles may call it only on accounts they opened: the method's
+	// request must name an account_id, or the order_id of an open order
+	// placed for one.
+	OwnerRoles []string `json:"owner_roles"`
+}
+
+// Policy declares, per gRPC method, the roles that may call it. Methods are
+// full names such as /market.v1.AdminService/HaltSymbol, or a service
+// followed by /* for every method of the service without its own rule.
+// Methods without a rule are denied to everyone.
+type Policy struct {
+	Methods map[string]Rule `json:"methods"`
+}
+
+// LoadPolicy reads a JSON policy file.
+func LoadPolicy(path string) (Policy, error) {
+	var policy Policy
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return policy, err
+	}
+
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return policy, err
+	}
+
+	if len(policy.Methods) == 0 {
+		return policy, fmt.Errorf("no methods: every call would be denied")
+	}
+	for method, rule := range policy.Methods {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			return policy, fmt.Errorf("methods: %q is not a /package.Service/Method name", method)
+		}
+		if len(rule.Roles) == 0 && len(rule.OwnerRoles) == 0 {
+			return policy, fmt.Errorf("methods: %s grants no roles", method)
+		}
+	}
+
+	return policy, nil
+}
+
+// Check compares the policy with the services a server registered. A rule
+// naming no registered method is an error, since it is most likely a typo
+// that leaves the method it meant denied; methods without a rule are
+// returned so they can be reported.
+func (policy Policy) Check(services map[string]grpc.ServiceInfo) ([]string, error) {
+	known := make(map[string]bool)
+	var unruled []string
+	for service, info := range services {
+		known["/"+service+"/*"] = true
+		for _, method := range info.Methods {
+			name := "/" + service + "/" + method.Name
+			known[name] = true
+			if _, ok := policy.rule(name); !ok {
+				unruled = append(unruled, name)
+			}
+		}
+	}
+
+	for method := range policy.Methods {
+		if !known[method] {
+			return nil, fmt.Errorf("methods: %s is not a method the server serves", method)
+		}
+	}
+
+	slices.Sort(unruled)
+	return unruled, nil
+}
+
+// access is what a policy lets a caller do with a method.
+type access int
+
+const (
+	denied access = iota
+	// anyAccount allows the call whatever account it names.
+	anyAccount
+	// ownAccounts allows the call only on accounts the caller opened.
+	ownAccounts
+)
+
+func (policy Policy) access(method string, identity Identity) access {
+	rule, ok := policy.rule(method)
+	switch {
+	case !ok:
+		return denied
+	case slices.ContainsFunc(rule.Roles, identity.HasRole):
+		return anyAccount
+	case slices.ContainsFunc(rule.OwnerRoles, identity.HasRole):
+		return ownAccounts
+	default:
+		return denied
+	}
+}
+
+// rule returns a method's own rule, or else its service's.
+func (policy Policy) rule(method string) (Rule, bool) {
+	if rule, ok := policy.Methods[method]; ok {
+		return rule, true
+	}
+
+	service := method[:strings.LastIndex(method, "/")]
+	rule, ok := policy.Methods[service+"/*"]
+	return rule, ok
+}
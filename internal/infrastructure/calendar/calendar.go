@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Jakarta is the exchange time zone; trading days are WIB calendar days.
+var Jakarta = time.FixedZone("WIB", 7*60*60)
+
+const dateLayout = "2006-01-02"
+
+// Calendar knows which days IDX trades: weekdays that are not exchange
+// holidays.
+type Calendar struct {
+	holidays map[time.Time]string
+}
+
+// File is a holiday list. Files may be written in YAML or JSON.
+type File struct {
+	Holidays []Holiday `yaml:"holidays"`
+}
+
+type Holiday struct {
+	// Date is YYYY-MM-DD.
+	Date string `yaml:"date"`
+	Name string `yaml:"name"`
+}
+
+// Weekdays is a calendar without holidays.
+func Weekdays() *Calendar {
+	return &Calendar{holidays: make(map[time.Time]string)}
+}
+
+func Load(path string) (*Calendar, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	calendar := Weekdays()
+	for i, holiday := range file.Holidays {
+		date, err := time.ParseInLocation(dateLayout, holiday.Date, Jakarta)
+		if err != nil {
+			return nil, fmt.Errorf("%s: holiday %d: %w", path, i+1, err)
+		}
+		calendar.holidays[date] = holiday.Name
+	}
+
+	return calendar, nil
+}
+
+// Day returns the WIB calendar day containing t, as midnight WIB.
+func Day(t time.Time) time.Time {
+	year, month, day := t.In(Jakarta).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, Jakarta)
+}
+
+// Holiday returns the name of the exchange holiday on the day containing t.
+func (calendar *Calendar) Holiday(t time.Time) (string, bool) {
+	name, exists := calendar.holidays[Day(t)]
+	return name, exists
+}
+
+func (calendar *Calendar) IsTradingDay(t time.Time) bool {
+	day := Day(t)
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return false
+	}
+
+	_, holiday := calendar.holidays[day]
+	return !holiday
+}
+
+// NextTradingDay returns the first trading day after the day containing t.
+func (calendar *Calendar) NextTradingDay(t time.Time) time.Time {
+	day := Day(t)
+	for {
+		day = day.AddDate(0, 0, 1)
+		if calendar.IsTradingDay(day) {
+			return day
+		}
+	}
+}
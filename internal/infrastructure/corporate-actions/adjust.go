@@ -0,0 +1,61 @@
+package corporateactions
+
+import (
+	"market-engine-go/internal/models"
+	"math"
+	"slices"
+	"time"
+)
+
+// AdjustBars returns a copy of a symbol's daily bars, oldest first, with
+// every bar before an action's ex-date moved to the units in effect after
+// it, so the series has no jumps at splits, dividends or rights issues.
+// Prices and changes are multiplied by the action's price factor and
+// volumes by its volume factor; values and frequencies are unchanged.
+//
+// Dividend and rights factors depend on the last close before the ex-date,
+// taken from the bars themselves; actions with no bar before their ex-date
+// do not affect any bar and are skipped.
+func AdjustBars(bars []models.DailyBar, actions []models.CorporateAction) []models.DailyBar {
+	priceFactors := make([]float64, len(bars))
+	volumeFactors := make([]float64, len(bars))
+	for i := range bars {
+		priceFactors[i], volumeFactors[i] = 1, 1
+	}
+
+	for _, action := range actions {
+		// The bars before the ex-date, which end with the unadjusted close
+		// the price factor is based on.
+		before, _ := slices.BinarySearchFunc(bars, action.ExDate, func(bar models.DailyBar, exDate time.Time) int {
+			return bar.Date.Compare(exDate)
+		})
+		if before == 0 {
+			continue
+		}
+
+		priceFactor := action.PriceFactor(bars[before-1].Close)
+		volumeFactor := action.VolumeFactor()
+		for i := range before {
+			priceFactors[i] *= priceFactor
+			volumeFactors[i] *= volumeFactor
+		}
+	}
+
+	adjusted := slices.Clone(bars)
+	for i := range adjusted {
+		bar := &adjusted[i]
+		bar.High = adjustPrice(bar.High, priceFactors[i])
+		bar.Low = adjustPrice(bar.Low, priceFactors[i])
+		bar.Close = adjustPrice(bar.Close, priceFactors[i])
+		bar.Change = adjustPrice(bar.Change, priceFactors[i])
+		bar.Volume = int64(math.Round(float64(bar.Volume) * volumeFactors[i]))
+	}
+
+	return adjusted
+}
+
+// adjustPrice scales a price, keeping four decimal places: adjusted prices
+// are off the tick grid, and more digits are only floating point noise.
+func adjustPrice(price float64, factor float64) float64 {
+	return math.Round(price*factor*1e4) / 1e4
+}
@@ -0,0 +1,62 @@
+package corporateactions
+
+import (
+	"context"
+	"log"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/infrastructure/repository"
+	"time"
+)
+
+// Applier puts scraped corporate actions into effect in the engine as their
+// ex-dates arrive on the engine clock.
+type Applier struct {
+	engine *marketengine.MarketEngine
+	store  repository.DatasetRepository
+	// Interval is how often saved actions are checked.
+	Interval time.Duration
+}
+
+func NewApplier(engine *marketengine.MarketEngine, store repository.DatasetRepository) *Applier {
+	return &Applier{
+		engine:   engine,
+		store:    store,
+		Interval: time.Minute,
+	}
+}
+
+// Run applies due actions now and then every interval until ctx ends.
+func (applier *Applier) Run(ctx context.Context) {
+	ticker := time.NewTicker(applier.Interval)
+	defer ticker.Stop()
+
+	for {
+		applier.Apply()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Apply applies the saved actions with an ex-date after the engine's
+// reference snapshot and on or before the engine's current day.
+func (applier *Applier) Apply() {
+	referenceDate := applier.engine.ReferenceDate()
+	if referenceDate.IsZero() {
+		return
+	}
+
+	actions, err := applier.store.CorporateActions("", referenceDate.AddDate(0, 0, 1), applier.engine.Now())
+	if err != nil {
+		log.Printf("[CorporateActions] Failed to load corporate actions: %v", err)
+		return
+	}
+
+	applied := applier.engine.ApplyCorporateActions(actions)
+	if len(applied) > 0 {
+		log.Printf("[CorporateActions] Applied %d corporate actions", len(applied))
+	}
+}
@@ -0,0 +1,457 @@
+package executions
+
+import (
+	"context"
+	"log"
+	"market-engine-go/internal/infrastructure/accounts"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"slices"
+	"sync"
+	"time"
+)
+
+// reportBuffer is how many reports a subscriber can fall behind by before
+// its stream is ended, so it resumes from the store instead.
+const reportBuffer = 256
+
+// saveInterval is how often reports are written to the history store.
+const saveInterval = 100 * time.Millisecond
+
+// Service reports the life of accounts' orders: acceptance, fills,
+// cancellation, adjustment by corporate actions, rejection and expiry.
+// Each account's reports are numbered without gaps and kept in the history
+// store, so a client can resume after the last report it received.
+type Service struct {
+	engine *marketengine.MarketEngine
+	ledger *accounts.Ledger
+	store  *repository.HistoryStore
+
+	mu sync.Mutex
+	// sequences are the last report sequence numbers, by account.
+	sequences map[string]uint64
+	// orders are accounts' working orders, by order ID.
+	orders map[string]*execution
+	// pending are fills of the order being submitted, which the engine
+	// records before it accepts the order.
+	pending []pendingFill
+	// unsaved are reports not yet in the store, in the order they were
+	// made.
+	unsaved            []models.ExecutionReport
+	subscriberSequence uint64
+	subscribers        map[uint64]*subscriber
+
+	// saveMu keeps one save running at a time.
+	saveMu sync.Mutex
+	done   chan struct{}
+}
+
+// execution is a working order as its reports have left it.
+type execution struct {
+	order models.Order
+	// notional is the value of the quantity filled, for its average price.
+	notional float64
+}
+
+type pendingFill struct {
+	orderID string
+	trade   models.Trade
+	fees    *models.Fees
+}
+
+type subscriber struct {
+	account string
+	reports chan models.ExecutionReport
+}
+
+func NewService(engine *marketengine.MarketEngine, ledger *accounts.Ledger, store *repository.HistoryStore) *Service {
+	return &Service{
+		engine:      engine,
+		ledger:      ledger,
+		store:       store,
+		sequences:   make(map[string]uint64),
+		orders:      make(map[string]*execution),
+		subscribers: make(map[uint64]*subscriber),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start picks up numbering and working orders where the store left them
+// and follows the engine's events. It must be called after the engine is
+// restored and before orders are placed. Working orders the engine no
+// longer has, because it was not restored, are reported cancelled.
+func (service *Service) Start() error {
+	sequences, err := service.store.LastExecutionSequences()
+	if err != nil {
+		return err
+	}
+
+	open, err := service.store.OpenExecutions()
+	if err != nil {
+		return err
+	}
+
+	resting := make(map[string]models.Order)
+	for _, order := range service.engine.Snapshot().Orders {
+		resting[order.ID] = order
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.sequences = sequences
+	for _, last := range open {
+		working := &execution{order: last.Order, notional: last.AveragePrice * float64(last.CumulativeQuantity)}
+
+		order, exists := resting[last.Order.ID]
+		if !exists || order.Owner != last.Account || !order.CreatedAt.Equal(last.Order.CreatedAt) {
+			working.order.Status = models.OrderStatusCancelled
+			working.order.UpdatedAt = service.engine.Now()
+			service.emit(working, models.ExecutionCancelled, working.order.UpdatedAt, "order was not restored after a restart")
+			continue
+		}
+
+		service.orders[order.ID] = working
+	}
+
+	service.engine.AddEventListener(service.onEvent)
+	log.Printf("[Executions] Following %d working account orders", len(service.orders))
+	return nil
+}
+
+// onEvent turns engine events about accounts' orders into reports. It runs
+// with the engine lock held.
+func (service *Service) onEvent(event models.Event) {
+	switch event.Type {
+	case models.EventTrade:
+		if event.Trade != nil {
+			service.onTrade(*event.Trade)
+		}
+	case models.EventOrderAccepted:
+		if event.Order != nil {
+			service.onAccepted(*event.Order, event.Timestamp)
+		}
+	case models.EventOrderCancelled, models.EventOrderExpired, models.EventOrderAdjusted:
+		if event.Order != nil {
+			service.onRemoved(event.Type, *event.Order, event.Timestamp)
+		}
+	}
+}
+
+func (service *Service) onTrade(trade models.Trade) {
+	sides := []struct {
+		orderID string
+		owner   string
+		fees    *models.Fees
+	}{
+		{trade.BuyOrderID, trade.Buyer, trade.BuyerFees},
+		{trade.SellOrderID, trade.Seller, trade.SellerFees},
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for _, side := range sides {
+		if side.orderID == "" {
+			continue
+		}
+
+		if working, exists := service.orders[side.orderID]; exists {
+			service.fill(working, trade, side.fees)
+		} else if service.ledger.Exists(side.owner) {
+			service.pending = append(service.pending, pendingFill{orderID: side.orderID, trade: trade, fees: side.fees})
+		}
+	}
+}
+
+// onAccepted reports a new order and then the fills it made on arrival,
+// and the cancellation of what a market order could not fill.
+func (service *Service) onAccepted(order models.Order, timestamp time.Time) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	pending := service.pending
+	service.pending = nil
+
+	if !service.ledger.Exists(order.Owner) {
+		return
+	}
+
+	final := order
+	order.Filled = 0
+	order.Status = models.OrderStatusNew
+
+	working := &execution{order: order}
+	service.orders[order.ID] = working
+	service.emit(working, models.ExecutionNew, timestamp, "")
+
+	for _, fill := range pending {
+		if fill.orderID == order.ID {
+			service.fill(working, fill.trade, fill.fees)
+		}
+	}
+
+	if final.Status == models.OrderStatusCancelled {
+		working.order.Status = models.OrderStatusCancelled
+		delete(service.orders, order.ID)
+		service.emit(working, models.ExecutionCancelled, timestamp, "market order quantity the book could not fill")
+	}
+}
+
+func (service *Service) onRemoved(eventType string, order models.Order, timestamp time.Time) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	working, exists := service.orders[order.ID]
+	if !exists {
+		return
+	}
+	working.order = order
+
+	switch eventType {
+	case models.EventOrderAdjusted:
+		service.emit(working, models.ExecutionReplaced, timestamp, "adjusted for a corporate action")
+	case models.EventOrderExpired:
+		delete(service.orders, order.ID)
+		service.emit(working, models.ExecutionExpired, timestamp, "")
+	default:
+		delete(service.orders, order.ID)
+		service.emit(working, models.ExecutionCancelled, timestamp, "")
+	}
+}
+
+// fill reports a fill of a working order. The caller must hold service.mu.
+func (service *Service) fill(working *execution, trade models.Trade, fees *models.Fees) {
+	working.order.Filled += trade.Size
+	working.order.UpdatedAt = trade.Timestamp
+	working.notional += trade.Price * float64(trade.Size)
+
+	executionType := models.ExecutionPartialFill
+	working.order.Status = models.OrderStatusPartiallyFilled
+	if working.order.Remaining() <= 0 {
+		executionType = models.ExecutionFill
+		working.order.Status = models.OrderStatusFilled
+		delete(service.orders, working.order.ID)
+	}
+
+	report := newReport(working, executionType, trade.Timestamp, "")
+	report.LastQuantity = trade.Size
+	report.LastPrice = trade.Price
+	report.TradeID = trade.ID
+	report.Fees = fees
+	service.publish(report)
+}
+
+// Reject reports an order an account placed that never reached the book,
+// with the OrderRejectReason name and the error that rejected it. Orders
+// of owners that are not accounts are ignored.
+func (service *Service) Reject(order models.Order, reason string, text string) {
+	if !service.ledger.Exists(order.Owner) {
+		return
+	}
+
+	now := service.engine.Now()
+	order.Status = models.OrderStatusRejected
+	order.Filled = 0
+	order.CreatedAt = now
+	order.UpdatedAt = now
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	report := newReport(&execution{order: order}, models.ExecutionRejected, now, text)
+	report.RejectReason = reason
+	service.publish(report)
+}
+
+// emit reports a working order as it now stands. The caller must hold
+// service.mu.
+func (service *Service) emit(working *execution, executionType string, timestamp time.Time, text string) {
+	service.publish(newReport(working, executionType, timestamp, text))
+}
+
+func newReport(working *execution, executionType string, timestamp time.Time, text string) models.ExecutionReport {
+	report := models.ExecutionReport{
+		Account:            working.order.Owner,
+		Type:               executionType,
+		Timestamp:          timestamp,
+		Order:              working.order,
+		CumulativeQuantity: working.order.Filled,
+		LeavesQuantity:     working.order.Remaining(),
+		Text:               text,
+	}
+
+	switch working.order.Status {
+	case models.OrderStatusCancelled, models.OrderStatusExpired, models.OrderStatusRejected:
+		report.LeavesQuantity = 0
+	}
+	if working.order.Filled > 0 {
+		report.AveragePrice = working.notional / float64(working.order.Filled)
+	}
+
+	return report
+}
+
+// publish numbers a report, queues it for the store and sends it to the
+// account's subscribers. A subscriber that has fallen too far behind has
+// its channel closed. The caller must hold service.mu.
+func (service *Service) publish(report models.ExecutionReport) {
+	service.sequences[report.Account]++
+	report.Sequence = service.sequences[report.Account]
+	service.unsaved = append(service.unsaved, report)
+
+	for key, subscriber := range service.subscribers {
+		if subscriber.account != report.Account {
+			continue
+		}
+
+		select {
+		case subscriber.reports <- report:
+		default:
+			log.Printf("[Executions] Subscriber %d of %s is not keeping up, ending its stream", key, report.Account)
+			close(subscriber.reports)
+			delete(service.subscribers, key)
+		}
+	}
+}
+
+// Subscribe returns an account's reports from sequence number from on that
+// were already made, a channel that receives the ones made after them, and
+// a function that ends the subscription. With from zero only new reports
+// are received. The channel is closed if the subscriber falls too far
+// behind; it can subscribe again from the report after the last one it
+// received.
+func (service *Service) Subscribe(account string, from uint64) ([]models.ExecutionReport, <-chan models.ExecutionReport, func(), error) {
+	if !service.ledger.Exists(account) {
+		return nil, nil, nil, accounts.ErrUnknownAccount
+	}
+
+	service.mu.Lock()
+	last := service.sequences[account]
+
+	var unsaved []models.ExecutionReport
+	if from > 0 {
+		for _, report := range service.unsaved {
+			if report.Account == account && report.Sequence >= from {
+				unsaved = append(unsaved, report)
+			}
+		}
+	}
+
+	service.subscriberSequence++
+	key := service.subscriberSequence
+	reports := make(chan models.ExecutionReport, reportBuffer)
+	service.subscribers[key] = &subscriber{account: account, reports: reports}
+	service.mu.Unlock()
+
+	unsubscribe := func() {
+		service.mu.Lock()
+		defer service.mu.Unlock()
+
+		delete(service.subscribers, key)
+	}
+
+	if from == 0 || from > last {
+		return nil, reports, unsubscribe, nil
+	}
+
+	// Reports not in the unsaved copy were saved before it was taken.
+	// Ones saved since are in both, and ones after last go to the channel.
+	stored, err := service.store.ExecutionReports(account, from)
+	if err != nil {
+		unsubscribe()
+		return nil, nil, nil, err
+	}
+
+	end := last + 1
+	if len(unsaved) > 0 {
+		end = unsaved[0].Sequence
+	}
+
+	var replay []models.ExecutionReport
+	for _, report := range stored {
+		if report.Sequence < end {
+			replay = append(replay, report)
+		}
+	}
+
+	return append(replay, unsaved...), reports, unsubscribe, nil
+}
+
+// Run writes reports to the history store until ctx is cancelled, then
+// writes what is left and closes Done. Reports that fail to save are
+// retried.
+func (service *Service) Run(ctx context.Context) {
+	defer close(service.done)
+
+	ticker := time.NewTicker(saveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			service.save()
+			return
+		case <-ticker.C:
+			service.save()
+		}
+	}
+}
+
+func (service *Service) Done() <-chan struct{} {
+	return service.done
+}
+
+// save writes the unsaved reports to the store and returns the first error.
+func (service *Service) save() error {
+	service.saveMu.Lock()
+	defer service.saveMu.Unlock()
+
+	service.mu.Lock()
+	batch := slices.Clone(service.unsaved)
+	service.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := service.store.SaveExecutionReports(batch); err != nil {
+		log.Printf("[Executions] Failed to save %d execution reports: %v", len(batch), err)
+		return err
+	}
+
+	// Reports are only ever appended, so the saved ones are still first.
+	service.mu.Lock()
+	service.unsaved = slices.Clone(service.unsaved[len(batch):])
+	service.mu.Unlock()
+
+	return nil
+}
+
+// Orders lists an account's orders as their last reports left them,
+// including reports not yet written, newest first, with the cursor of the
+// next page.
+func (service *Service) Orders(query repository.HistoryQuery) ([]models.ExecutionReport, uint64, error) {
+	if !service.ledger.Exists(query.Account) {
+		return nil, 0, accounts.ErrUnknownAccount
+	}
+	if err := service.save(); err != nil {
+		return nil, 0, err
+	}
+
+	return service.store.Orders(query)
+}
+
+// Trades lists an account's fills, including ones not yet written, newest
+// first, with the cursor of the next page.
+func (service *Service) Trades(query repository.HistoryQuery) ([]models.AccountTrade, uint64, error) {
+	if !service.ledger.Exists(query.Account) {
+		return nil, 0, accounts.ErrUnknownAccount
+	}
+	if err := service.save(); err != nil {
+		return nil, 0, err
+	}
+
+	return service.store.Trades(query)
+}
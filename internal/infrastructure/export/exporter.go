@@ -0,0 +1,166 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Exporter writes the engine's trades and ticker updates to rotating files
+// under trades/ and ticks/ in the export directory.
+type Exporter struct {
+	trades  *RotatingWriter[Trade]
+	ticks   *RotatingWriter[Tick]
+	events  chan models.Event
+	done    chan struct{}
+	dropped int
+}
+
+func NewExporter(options Options) (*Exporter, error) {
+	tradeOptions := options
+	tradeOptions.Dir = filepath.Join(options.Dir, "trades")
+	trades, err := NewRotatingWriter[Trade](tradeOptions, "trades")
+	if err != nil {
+		return nil, err
+	}
+
+	tickOptions := options
+	tickOptions.Dir = filepath.Join(options.Dir, "ticks")
+	ticks, err := NewRotatingWriter[Tick](tickOptions, "ticks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Exporter{
+		trades: trades,
+		ticks:  ticks,
+		events: make(chan models.Event, 10000),
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Record queues an engine event. It never blocks, so it can be registered
+// as an engine event listener; events are dropped if the writer falls
+// behind.
+func (exporter *Exporter) Record(event models.Event) {
+	if event.Type != models.EventTrade && event.Type != models.EventPriceUpdate {
+		return
+	}
+
+	select {
+	case exporter.events <- event:
+	default:
+		exporter.dropped++
+		if exporter.dropped == 1 || exporter.dropped%1000 == 0 {
+			log.Printf("[Export] Exporter is behind, %d events dropped", exporter.dropped)
+		}
+	}
+}
+
+// Run writes queued events once a second until ctx is cancelled, then
+// writes what is left, closes the current files and closes Done.
+func (exporter *Exporter) Run(ctx context.Context) {
+	defer close(exporter.done)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var trades []Trade
+	var ticks []Tick
+	flush := func() {
+		if err := exporter.trades.Write(trades); err != nil {
+			log.Printf("[Export] Failed to write %d trades: %v", len(trades), err)
+		}
+		if err := exporter.ticks.Write(ticks); err != nil {
+			log.Printf("[Export] Failed to write %d ticks: %v", len(ticks), err)
+		}
+		trades, ticks = trades[:0], ticks[:0]
+	}
+
+	add := func(event models.Event) {
+		switch event.Type {
+		case models.EventTrade:
+			trades = append(trades, tradeRecord(*event.Trade))
+		case models.EventPriceUpdate:
+			ticks = append(ticks, Tick{Sequence: event.Sequence, Ticker: event.Ticker, Price: event.Price, Timestamp: event.Timestamp.UTC()})
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case event := <-exporter.events:
+					add(event)
+				default:
+					flush()
+					if err := exporter.trades.Close(); err != nil {
+						log.Printf("[Export] Failed to close trade file: %v", err)
+					}
+					if err := exporter.ticks.Close(); err != nil {
+						log.Printf("[Export] Failed to close tick file: %v", err)
+					}
+					return
+				}
+			}
+		case event := <-exporter.events:
+			add(event)
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (exporter *Exporter) Done() <-chan struct{} {
+	return exporter.done
+}
+
+// ExportSnapshots writes each daily snapshot in the repository to
+// stocks_idx_YYYY-MM-DD.<format> in dir, skipping days already exported
+// unless overwrite is set. It returns how many files were written.
+func ExportSnapshots(repo repository.StockRepository, dir string, format string, overwrite bool) (int, error) {
+	if err := (Options{Format: format}).validate(); err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return 0, err
+	}
+
+	dates, err := repo.Dates()
+	if err != nil {
+		return 0, err
+	}
+
+	written := 0
+	for _, date := range dates {
+		path := filepath.Join(dir, fmt.Sprintf("stocks_idx_%s.%s", date.Format("2006-01-02"), format))
+		if _, err := os.Stat(path); err == nil && !overwrite {
+			continue
+		}
+
+		_, bars, err := repo.Load(date)
+		if err != nil {
+			return written, err
+		}
+
+		rows := make([]DailyBar, 0, len(bars))
+		for _, bar := range bars {
+			rows = append(rows, dailyBarRecord(bar))
+		}
+
+		if err := WriteFile(path, format, rows); err != nil {
+			return written, fmt.Errorf("%s: %w", path, err)
+		}
+
+		written++
+	}
+
+	return written, nil
+}
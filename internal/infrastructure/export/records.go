@@ -0,0 +1,89 @@
+package export
+
+import (
+	"encoding/json"
+	"market-engine-go/internal/models"
+	"time"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// Trade is one engine trade as exported.
+type Trade struct {
+	ID          string    `json:"id" parquet:"id"`
+	Ticker      string    `json:"ticker" parquet:"ticker,dict"`
+	Price       float64   `json:"price" parquet:"price"`
+	Size        int64     `json:"size" parquet:"size"`
+	Side        string    `json:"side" parquet:"side,dict"`
+	Timestamp   time.Time `json:"timestamp" parquet:"timestamp,timestamp(millisecond)"`
+	BuyOrderID  string    `json:"buy_order_id" parquet:"buy_order_id"`
+	SellOrderID string    `json:"sell_order_id" parquet:"sell_order_id"`
+	Buyer       string    `json:"buyer" parquet:"buyer,dict"`
+	Seller      string    `json:"seller" parquet:"seller,dict"`
+}
+
+// Tick is one last-price update, as streamed to StreamTickers clients.
+type Tick struct {
+	Sequence  uint64    `json:"seq" parquet:"seq"`
+	Ticker    string    `json:"ticker" parquet:"ticker,dict"`
+	Price     float64   `json:"price" parquet:"price"`
+	Timestamp time.Time `json:"timestamp" parquet:"timestamp,timestamp(millisecond)"`
+}
+
+// DailyBar is one row of a daily snapshot with typed fields.
+type DailyBar struct {
+	// Date is the trading day as days since 1970-01-01, Parquet's DATE
+	// encoding.
+	Date      int32   `json:"date" parquet:"date,date"`
+	Code      string  `json:"code" parquet:"code"`
+	Name      string  `json:"name" parquet:"name"`
+	High      float64 `json:"high" parquet:"high"`
+	Low       float64 `json:"low" parquet:"low"`
+	Close     float64 `json:"close" parquet:"close"`
+	Change    float64 `json:"change" parquet:"change"`
+	Volume    int64   `json:"volume" parquet:"volume"`
+	Value     int64   `json:"value" parquet:"value"`
+	Frequency int64   `json:"frequency" parquet:"frequency"`
+}
+
+// MarshalJSON writes the date as YYYY-MM-DD.
+func (bar DailyBar) MarshalJSON() ([]byte, error) {
+	type fields DailyBar
+	date := time.Unix(int64(bar.Date)*secondsPerDay, 0).UTC()
+
+	return json.Marshal(struct {
+		Date string `json:"date"`
+		fields
+	}{date.Format("2006-01-02"), fields(bar)})
+}
+
+func tradeRecord(trade models.Trade) Trade {
+	return Trade{
+		ID:          trade.ID,
+		Ticker:      trade.Ticker,
+		Price:       trade.Price,
+		Size:        int64(trade.Size),
+		Side:        trade.Side,
+		Timestamp:   trade.Timestamp.UTC(),
+		BuyOrderID:  trade.BuyOrderID,
+		SellOrderID: trade.SellOrderID,
+		Buyer:       trade.Buyer,
+		Seller:      trade.Seller,
+	}
+}
+
+func dailyBarRecord(bar models.DailyBar) DailyBar {
+	return DailyBar{
+		// Count the WIB calendar day, not the UTC instant it starts at.
+		Date:      int32(time.Date(bar.Date.Year(), bar.Date.Month(), bar.Date.Day(), 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay),
+		Code:      bar.Code,
+		Name:      bar.Name,
+		High:      bar.High,
+		Low:       bar.Low,
+		Close:     bar.Close,
+		Change:    bar.Change,
+		Volume:    bar.Volume,
+		Value:     bar.Value,
+		Frequency: bar.Frequency,
+	}
+}
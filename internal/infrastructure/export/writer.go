@@ -0,0 +1,253 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+const (
+	FormatParquet = "parquet"
+	FormatJSONL   = "jsonl"
+)
+
+// partialSuffix marks a file that is still being written. Parquet files
+// are unreadable until their footer is written on close, so readers
+// globbing *.parquet only see complete files.
+const partialSuffix = ".partial"
+
+// Options controls where exported files go and when they are rotated.
+type Options struct {
+	Dir    string
+	Format string
+	// MaxAge starts a new file once the current one has been open this
+	// long; zero disables time-based rotation.
+	MaxAge time.Duration
+	// MaxBytes starts a new file once the current one has grown past this
+	// size; zero disables size-based rotation.
+	MaxBytes int64
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Dir:      "./output/export",
+		Format:   FormatParquet,
+		MaxAge:   time.Hour,
+		MaxBytes: 64 << 20,
+	}
+}
+
+func (options Options) validate() error {
+	switch options.Format {
+	case FormatParquet, FormatJSONL:
+		return nil
+	default:
+		return fmt.Errorf("unknown export format %q", options.Format)
+	}
+}
+
+type encoder[T any] interface {
+	write(rows []T) error
+	// flush writes buffered rows through to the file.
+	flush() error
+	close() error
+}
+
+func newEncoder[T any](format string, output io.Writer) encoder[T] {
+	if format == FormatJSONL {
+		buffered := bufio.NewWriter(output)
+		return &jsonlEncoder[T]{buffered: buffered, encoder: json.NewEncoder(buffered)}
+	}
+
+	// Without parquet's own write buffer every flushed row group reaches the
+	// output, so size-based rotation sees it.
+	return &parquetEncoder[T]{writer: parquet.NewGenericWriter[T](output, parquet.Compression(&parquet.Zstd), parquet.WriteBufferSize(0))}
+}
+
+type jsonlEncoder[T any] struct {
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+}
+
+func (encoder *jsonlEncoder[T]) write(rows []T) error {
+	for _, row := range rows {
+		if err := encoder.encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (encoder *jsonlEncoder[T]) flush() error {
+	return encoder.buffered.Flush()
+}
+
+func (encoder *jsonlEncoder[T]) close() error {
+	return encoder.buffered.Flush()
+}
+
+type parquetEncoder[T any] struct {
+	writer *parquet.GenericWriter[T]
+}
+
+func (encoder *parquetEncoder[T]) write(rows []T) error {
+	_, err := encoder.writer.Write(rows)
+	return err
+}
+
+// flush ends the current row group so its size shows on disk.
+func (encoder *parquetEncoder[T]) flush() error {
+	return encoder.writer.Flush()
+}
+
+func (encoder *parquetEncoder[T]) close() error {
+	return encoder.writer.Close()
+}
+
+type countingWriter struct {
+	output  io.Writer
+	written int64
+}
+
+func (writer *countingWriter) Write(p []byte) (int, error) {
+	n, err := writer.output.Write(p)
+	writer.written += int64(n)
+	return n, err
+}
+
+// RotatingWriter appends rows to a series of files named
+// <prefix>-<UTC start time to the millisecond>.<format>, starting a new one
+// when the current file is too old or too large. It is not safe for
+// concurrent use.
+type RotatingWriter[T any] struct {
+	options Options
+	prefix  string
+
+	file    *os.File
+	path    string
+	counter *countingWriter
+	encoder encoder[T]
+	opened  time.Time
+}
+
+func NewRotatingWriter[T any](options Options, prefix string) (*RotatingWriter[T], error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(options.Dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	return &RotatingWriter[T]{options: options, prefix: prefix}, nil
+}
+
+// Write appends a batch of rows, rotating first if the current file is due.
+func (writer *RotatingWriter[T]) Write(rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	if writer.file != nil && writer.due(now) {
+		if err := writer.Close(); err != nil {
+			return err
+		}
+	}
+
+	if writer.file == nil {
+		if err := writer.open(now); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.encoder.write(rows); err != nil {
+		return err
+	}
+
+	return writer.encoder.flush()
+}
+
+func (writer *RotatingWriter[T]) due(now time.Time) bool {
+	if writer.options.MaxAge > 0 && now.Sub(writer.opened) >= writer.options.MaxAge {
+		return true
+	}
+
+	return writer.options.MaxBytes > 0 && writer.counter.written >= writer.options.MaxBytes
+}
+
+func (writer *RotatingWriter[T]) open(now time.Time) error {
+	utc := now.UTC()
+	name := fmt.Sprintf("%s-%s%03dZ.%s", writer.prefix, utc.Format("20060102T150405"), utc.Nanosecond()/int(time.Millisecond), writer.options.Format)
+	path := filepath.Join(writer.options.Dir, name)
+
+	file, err := os.Create(path + partialSuffix)
+	if err != nil {
+		return err
+	}
+
+	writer.file = file
+	writer.path = path
+	writer.counter = &countingWriter{output: file}
+	writer.encoder = newEncoder[T](writer.options.Format, writer.counter)
+	writer.opened = now
+
+	return nil
+}
+
+// Close finishes the current file, if any. The next Write starts a new one.
+func (writer *RotatingWriter[T]) Close() error {
+	if writer.file == nil {
+		return nil
+	}
+
+	file, path := writer.file, writer.path
+	writer.file = nil
+
+	encodeErr := writer.encoder.close()
+	closeErr := file.Close()
+	if encodeErr != nil {
+		return encodeErr
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(path+partialSuffix, path)
+}
+
+// WriteFile writes all rows to a single file in the given format.
+func WriteFile[T any](path string, format string, rows []T) error {
+	if err := (Options{Format: format}).validate(); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path + partialSuffix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path + partialSuffix)
+
+	encoder := newEncoder[T](format, file)
+	if err := encoder.write(rows); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := encoder.close(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(path+partialSuffix, path)
+}
@@ -0,0 +1,141 @@
+package grpcserver
+
+import (
+	"context"
+	marketv1 "market-engine-go/gen/go/market/v1"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+func (server *AccountServer) ListOrders(ctx context.Context, req *marketv1.ListOrdersRequest) (*marketv1.ListOrdersResponse, error) {
+	query, err := server.historyQuery(req.GetAccountId(), req.GetFromDate(), req.GetToDate(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	query.Symbol = req.GetSymbol()
+	query.Side = sideFromProto(req.GetSide())
+	query.Status = orderStatusFromProto(req.GetStatus())
+
+	orders, next, err := server.Executions.Orders(query)
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	res := &marketv1.ListOrdersResponse{NextPageToken: pageToken(next)}
+	for _, last := range orders {
+		res.Orders = append(res.Orders, &marketv1.AccountOrder{
+			Order:        orderToProto(last.Order),
+			AveragePrice: last.AveragePrice,
+			RejectReason: rejectReasonToProto(last.RejectReason),
+			Text:         last.Text,
+		})
+	}
+
+	return res, nil
+}
+
+func (server *AccountServer) ListMyTrades(ctx context.Context, req *marketv1.ListMyTradesRequest) (*marketv1.ListMyTradesResponse, error) {
+	query, err := server.historyQuery(req.GetAccountId(), req.GetFromDate(), req.GetToDate(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	query.Symbol = req.GetSymbol()
+	query.Side = sideFromProto(req.GetSide())
+
+	trades, next, err := server.Executions.Trades(query)
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	res := &marketv1.ListMyTradesResponse{NextPageToken: pageToken(next)}
+	for _, trade := range trades {
+		res.Trades = append(res.Trades, &marketv1.AccountTrade{
+			TradeId:   trade.TradeID,
+			OrderId:   trade.OrderID,
+			Symbol:    trade.Symbol,
+			Side:      sideToProto(trade.Side),
+			Price:     trade.Price,
+			Quantity:  int64(trade.Quantity),
+			Fees:      feesToProto(trade.Fees),
+			Timestamp: trade.Timestamp.UnixMilli(),
+		})
+	}
+
+	return res, nil
+}
+
+// historyQuery reads the account, date range and page shared by the order
+// and trade listings.
+func (server *AccountServer) historyQuery(accountID string, fromDate string, toDate string, pageSize int32, token string) (repository.HistoryQuery, error) {
+	query := repository.HistoryQuery{Account: accountID, Limit: defaultPageSize}
+	if server.Executions == nil {
+		return query, status.Error(codes.Unavailable, "order history is not configured")
+	}
+	if accountID == "" {
+		return query, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+
+	var err error
+	if query.From, err = parseHistoryDate(fromDate); err != nil {
+		return query, status.Errorf(codes.InvalidArgument, "from_date: %v", err)
+	}
+	if query.To, err = parseHistoryDate(toDate); err != nil {
+		return query, status.Errorf(codes.InvalidArgument, "to_date: %v", err)
+	}
+	if !query.To.IsZero() {
+		query.To = query.To.AddDate(0, 0, 1)
+	}
+
+	switch {
+	case pageSize < 0:
+		return query, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize > 0:
+		query.Limit = min(int(pageSize), maxPageSize)
+	}
+
+	if token != "" {
+		if query.Before, err = strconv.ParseUint(token, 10, 64); err != nil || query.Before == 0 {
+			return query, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+
+	return query, nil
+}
+
+// pageToken is the token of the page starting after cursor; zero means
+// there are no more pages.
+func pageToken(cursor uint64) string {
+	if cursor == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(cursor, 10)
+}
+
+func orderStatusFromProto(orderStatus marketv1.OrderStatus) string {
+	switch orderStatus {
+	case marketv1.OrderStatus_ORDER_STATUS_NEW:
+		return models.OrderStatusNew
+	case marketv1.OrderStatus_ORDER_STATUS_PARTIALLY_FILLED:
+		return models.OrderStatusPartiallyFilled
+	case marketv1.OrderStatus_ORDER_STATUS_FILLED:
+		return models.OrderStatusFilled
+	case marketv1.OrderStatus_ORDER_STATUS_CANCELLED:
+		return models.OrderStatusCancelled
+	case marketv1.OrderStatus_ORDER_STATUS_EXPIRED:
+		return models.OrderStatusExpired
+	case marketv1.OrderStatus_ORDER_STATUS_REJECTED:
+		return models.OrderStatusRejected
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,289 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	marketv1 "market-engine-go/gen/go/market/v1"
+	"market-engine-go/internal/infrastructure/accounts"
+	"market-engine-go/internal/infrastructure/auth"
+	"market-engine-go/internal/infrastructure/executions"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/infrastructure/portfolio"
+	"market-engine-go/internal/models"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AccountServer struct {
+	marketv1.UnimplementedAccountServiceServer
+	Engine     *marketengine.MarketEngine
+	Ledger     *accounts.Ledger
+	Portfolios *portfolio.Service
+	// Executions back the order and trade history.
+	Executions *executions.Service
+}
+
+func (server *AccountServer) CreateAccount(ctx context.Context, req *marketv1.CreateAccountRequest) (*marketv1.CreateAccountResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	// The caller owns the account it opens, so the authorization policy can
+	// limit it to its own accounts.
+	var owner string
+	if identity, ok := auth.FromContext(ctx); ok {
+		owner = identity.Subject
+	}
+
+	account, err := server.Ledger.Open(req.GetName(), req.GetTier(), req.GetCash(), req.GetMargin(), owner)
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	res, _ := server.valueAccount(account)
+	return &marketv1.CreateAccountResponse{Account: res}, nil
+}
+
+func (server *AccountServer) FundAccount(ctx context.Context, req *marketv1.FundAccountRequest) (*marketv1.FundAccountResponse, error) {
+	account, err := server.Ledger.Fund(req.GetAccountId(), req.GetAmount())
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	res, _ := server.valueAccount(account)
+	return &marketv1.FundAccountResponse{Account: res}, nil
+}
+
+func (server *AccountServer) WithdrawFunds(ctx context.Context, req *marketv1.WithdrawFundsRequest) (*marketv1.WithdrawFundsResponse, error) {
+	account, err := server.Ledger.Withdraw(req.GetAccountId(), req.GetAmount())
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	res, _ := server.valueAccount(account)
+	return &marketv1.WithdrawFundsResponse{Account: res}, nil
+}
+
+func (server *AccountServer) GetAccount(ctx context.Context, req *marketv1.GetAccountRequest) (*marketv1.GetAccountResponse, error) {
+	account, err := server.Ledger.Account(req.GetAccountId())
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	res, positions := server.valueAccount(account)
+	unsettled := make([]*marketv1.Settlement, 0, len(account.Unsettled))
+	for _, settlement := range account.Unsettled {
+		unsettled = append(unsettled, &marketv1.Settlement{
+			TradeId:        settlement.TradeID,
+			Symbol:         settlement.Symbol,
+			Side:           sideToProto(settlement.Side),
+			Quantity:       int64(settlement.Quantity),
+			Amount:         settlement.Amount,
+			TradeDate:      formatHistoryDate(settlement.TradeDate),
+			SettlementDate: formatHistoryDate(settlement.SettlementDate),
+		})
+	}
+
+	return &marketv1.GetAccountResponse{Account: res, Positions: openPositions(positions), Unsettled: unsettled}, nil
+}
+
+func (server *AccountServer) ListPositions(ctx context.Context, req *marketv1.ListPositionsRequest) (*marketv1.ListPositionsResponse, error) {
+	account, err := server.Ledger.Account(req.GetAccountId())
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	_, positions := server.valueAccount(account)
+	if !req.GetIncludeClosed() {
+		positions = openPositions(positions)
+	}
+
+	return &marketv1.ListPositionsResponse{Positions: positions}, nil
+}
+
+// StreamAccountUpdates sends the account as it stands and then the account
+// after every change to it, with the positions that changed.
+func (server *AccountServer) StreamAccountUpdates(req *marketv1.StreamAccountUpdatesRequest, stream marketv1.AccountService_StreamAccountUpdatesServer) error {
+	updates, unsubscribe, err := server.Ledger.Subscribe(req.GetAccountId())
+	if err != nil {
+		return accountError(err)
+	}
+	defer unsubscribe()
+
+	account, err := server.Ledger.Account(req.GetAccountId())
+	if err != nil {
+		return accountError(err)
+	}
+
+	res, positions := server.valueAccount(account)
+	err = stream.Send(&marketv1.StreamAccountUpdatesResponse{
+		Reason:    marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_SNAPSHOT,
+		Timestamp: server.Engine.Now().UnixMilli(),
+		Account:   res,
+		Positions: positions,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case update := <-updates:
+			res, positions := server.valueAccount(update.Account)
+			changed := make([]*marketv1.Position, 0, len(update.Symbols))
+			for _, position := range positions {
+				if slices.Contains(update.Symbols, position.Symbol) {
+					changed = append(changed, position)
+				}
+			}
+
+			msg := &marketv1.StreamAccountUpdatesResponse{
+				Reason:    accountUpdateReasonToProto(update.Reason),
+				Timestamp: update.Timestamp.UnixMilli(),
+				Account:   res,
+				Positions: changed,
+			}
+			if update.Trade != nil {
+				msg.Trade = tradeToProto(*update.Trade)
+				msg.Trade.Fees = feesToProto(update.Fees)
+			}
+
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (server *AccountServer) GetMarginSettings(ctx context.Context, req *marketv1.GetMarginSettingsRequest) (*marketv1.GetMarginSettingsResponse, error) {
+	settings := server.Ledger.Margin
+
+	return &marketv1.GetMarginSettingsResponse{
+		InitialRatio:     settings.InitialRatio,
+		MaintenanceRatio: settings.MaintenanceRatio,
+		LiquidationRatio: settings.LiquidationRatio,
+		CallGraceMs:      int64(settings.CallGraceMs),
+		ShortSelling:     settings.ShortSelling,
+		Marginable:       settings.Marginable,
+	}, nil
+}
+
+// valueAccount values an account's positions at last prices.
+func (server *AccountServer) valueAccount(account models.Account) (*marketv1.Account, []*marketv1.Position) {
+	portfolio := server.Portfolios.Value(account)
+
+	res := &marketv1.Account{
+		Id:            account.ID,
+		Name:          account.Name,
+		Tier:          account.Tier,
+		Owner:         account.Owner,
+		Cash:          account.Cash,
+		ReservedCash:  account.Reserved,
+		BuyingPower:   server.Ledger.BuyingPower(account),
+		CreatedAt:     account.CreatedAt.UnixMilli(),
+		MarketValue:   portfolio.MarketValue,
+		Equity:        portfolio.Equity,
+		RealizedPnl:   portfolio.RealizedPnL,
+		UnrealizedPnl: portfolio.UnrealizedPnL,
+		FeesPaid:      account.FeesPaid,
+
+		SettledCash:       account.SettledCash(),
+		UnsettledProceeds: account.UnsettledProceeds(),
+		Withdrawable:      server.Ledger.Withdrawable(account),
+		Margin:            account.Margin,
+	}
+	if account.Margin {
+		res.MarginStatus = marginStatusToProto(server.Ledger.MarginStatus(account), account.MarginCall)
+	}
+
+	positions := make([]*marketv1.Position, 0, len(portfolio.Positions))
+	for _, position := range portfolio.Positions {
+		positions = append(positions, positionToProto(position))
+	}
+
+	return res, positions
+}
+
+func positionToProto(position models.PositionValue) *marketv1.Position {
+	return &marketv1.Position{
+		Symbol:           position.Symbol,
+		Quantity:         int64(position.Quantity),
+		ReservedQuantity: int64(position.Reserved),
+		AverageCost:      position.AverageCost,
+		LastPrice:        position.LastPrice,
+		MarketValue:      position.MarketValue,
+		UnrealizedPnl:    position.UnrealizedPnL,
+		RealizedPnl:      position.RealizedPnL,
+		SettledQuantity:  int64(position.SettledQuantity),
+		Sector:           position.Sector,
+	}
+}
+
+func marginStatusToProto(margin models.MarginStatus, call *models.MarginCall) *marketv1.MarginStatus {
+	res := &marketv1.MarginStatus{
+		LongValue:              margin.LongValue,
+		ShortValue:             margin.ShortValue,
+		InitialRequirement:     margin.InitialRequirement,
+		MaintenanceRequirement: margin.MaintenanceRequirement,
+		LiquidationRequirement: margin.LiquidationRequirement,
+		ExcessEquity:           margin.Excess,
+	}
+	if call != nil {
+		res.MarginCall = &marketv1.MarginCall{
+			IssuedAt:    call.IssuedAt.UnixMilli(),
+			Deadline:    call.Deadline.UnixMilli(),
+			Equity:      call.Equity,
+			Requirement: call.Requirement,
+		}
+	}
+
+	return res
+}
+
+func openPositions(positions []*marketv1.Position) []*marketv1.Position {
+	return slices.DeleteFunc(positions, func(position *marketv1.Position) bool {
+		return position.Quantity == 0
+	})
+}
+
+func accountError(err error) error {
+	switch {
+	case errors.Is(err, accounts.ErrUnknownAccount):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, accounts.ErrInvalidAmount), errors.Is(err, accounts.ErrUnknownTier):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, accounts.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func accountUpdateReasonToProto(reason string) marketv1.AccountUpdateReason {
+	switch reason {
+	case models.AccountUpdateOpened:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_OPENED
+	case models.AccountUpdateFunded:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_FUNDED
+	case models.AccountUpdateOrder:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_ORDER
+	case models.AccountUpdateFill:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_FILL
+	case models.AccountUpdateCorporateAction:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_CORPORATE_ACTION
+	case models.AccountUpdateWithdrawn:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_WITHDRAWN
+	case models.AccountUpdateSettled:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_SETTLED
+	case models.AccountUpdateMarginCall:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_MARGIN_CALL
+	case models.AccountUpdateMarginCallMet:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_MARGIN_CALL_MET
+	default:
+		return marketv1.AccountUpdateReason_ACCOUNT_UPDATE_REASON_UNSPECIFIED
+	}
+}
@@ -0,0 +1,170 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log"
+	marketv1 "market-engine-go/gen/go/market/v1"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/infrastructure/replay"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/infrastructure/scenario"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminServer struct {
+	marketv1.UnimplementedAdminServiceServer
+	Engine *marketengine.MarketEngine
+	// Replay is set when the engine is replaying a tape.
+	Replay *replay.Player
+	// Snapshots is where reference data is reloaded from.
+	Snapshots repository.StockRepository
+
+	mu           sync.Mutex
+	stopScenario context.CancelFunc
+}
+
+func (server *AdminServer) HaltSymbol(ctx context.Context, req *marketv1.HaltSymbolRequest) (*marketv1.HaltSymbolResponse, error) {
+	var until time.Time
+	if req.GetDurationMs() > 0 {
+		until = server.Engine.Now().Add(time.Duration(req.GetDurationMs()) * time.Millisecond)
+	}
+
+	if err := server.Engine.Halt(req.GetSymbol(), until); err != nil {
+		return nil, engineError(err)
+	}
+
+	log.Printf("[Admin] %s halted %s", caller(ctx), req.GetSymbol())
+	return &marketv1.HaltSymbolResponse{}, nil
+}
+
+func (server *AdminServer) ResumeSymbol(ctx context.Context, req *marketv1.ResumeSymbolRequest) (*marketv1.ResumeSymbolResponse, error) {
+	if err := server.Engine.Resume(req.GetSymbol()); err != nil {
+		return nil, engineError(err)
+	}
+
+	log.Printf("[Admin] %s resumed %s", caller(ctx), req.GetSymbol())
+	return &marketv1.ResumeSymbolResponse{}, nil
+}
+
+// RunScenario validates the definition and runs it in the background,
+// replacing any scenario that is still running.
+func (server *AdminServer) RunScenario(ctx context.Context, req *marketv1.RunScenarioRequest) (*marketv1.RunScenarioResponse, error) {
+	definition, err := scenario.Parse([]byte(req.GetDefinition()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	runner := scenario.NewRunner(server.Engine, definition)
+	if err := runner.Check(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	server.mu.Lock()
+	if server.stopScenario != nil {
+		server.stopScenario()
+	}
+	scenarioCtx, cancel := context.WithCancel(context.Background())
+	server.stopScenario = cancel
+	server.mu.Unlock()
+
+	go func() {
+		if err := runner.Run(scenarioCtx); err != nil && scenarioCtx.Err() == nil {
+			log.Printf("[Admin] Scenario %q failed: %v", definition.Name, err)
+		}
+	}()
+
+	return &marketv1.RunScenarioResponse{
+		Name:       definition.Name,
+		EventCount: int32(len(definition.Events)),
+	}, nil
+}
+
+func (server *AdminServer) StopScenario(ctx context.Context, req *marketv1.StopScenarioRequest) (*marketv1.StopScenarioResponse, error) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if server.stopScenario == nil {
+		return &marketv1.StopScenarioResponse{Stopped: false}, nil
+	}
+
+	server.stopScenario()
+	server.stopScenario = nil
+
+	return &marketv1.StopScenarioResponse{Stopped: true}, nil
+}
+
+func (server *AdminServer) ControlReplay(ctx context.Context, req *marketv1.ControlReplayRequest) (*marketv1.ControlReplayResponse, error) {
+	if server.Replay == nil {
+		return nil, status.Error(codes.FailedPrecondition, "engine is not in replay mode")
+	}
+
+	var current replay.Status
+	switch req.GetAction() {
+	case marketv1.ReplayAction_REPLAY_ACTION_STATUS:
+		current = server.Replay.Status()
+	case marketv1.ReplayAction_REPLAY_ACTION_PLAY:
+		current = server.Replay.Play()
+	case marketv1.ReplayAction_REPLAY_ACTION_PAUSE:
+		current = server.Replay.Pause()
+	case marketv1.ReplayAction_REPLAY_ACTION_STEP:
+		steps := int(req.GetSteps())
+		if steps <= 0 {
+			steps = 1
+		}
+		current = server.Replay.Step(steps)
+	case marketv1.ReplayAction_REPLAY_ACTION_SEEK:
+		current = server.Replay.Seek(time.UnixMilli(req.GetSeekTimeMs()))
+	case marketv1.ReplayAction_REPLAY_ACTION_SET_SPEED:
+		var err error
+		current, err = server.Replay.SetSpeed(req.GetSpeed())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "replay action is required")
+	}
+
+	return &marketv1.ControlReplayResponse{
+		Position: int32(current.Position),
+		Length:   int32(current.Length),
+		Playing:  current.Playing,
+		Speed:    current.Speed,
+		TimeMs:   current.Time.UnixMilli(),
+	}, nil
+}
+
+func (server *AdminServer) ReloadReferenceData(ctx context.Context, req *marketv1.ReloadReferenceDataRequest) (*marketv1.ReloadReferenceDataResponse, error) {
+	if server.Snapshots == nil {
+		return nil, status.Error(codes.Unavailable, "daily snapshots are not configured")
+	}
+
+	if server.Replay != nil {
+		return nil, status.Error(codes.FailedPrecondition, "reference data cannot be reloaded during a replay")
+	}
+
+	date, err := parseHistoryDate(req.GetDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "date: %v", err)
+	}
+
+	result, err := server.Engine.ReloadReferenceData(server.Snapshots, date)
+	if errors.Is(err, repository.ErrNoSnapshot) {
+		return nil, status.Errorf(codes.NotFound, "no snapshot for %s", req.GetDate())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.Printf("[Admin] %s reloaded reference data from the %s snapshot", caller(ctx), result.Date.Format("2006-01-02"))
+	return &marketv1.ReloadReferenceDataResponse{
+		Date:        result.Date.Format("2006-01-02"),
+		SymbolCount: int32(result.Symbols),
+		Listed:      result.Listed,
+		Delisted:    result.Delisted,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package grpcserver
+
+import (
+	marketv1 "market-engine-go/gen/go/market/v1"
+	"market-engine-go/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamExecutionReports streams reports on an account's orders, first
+// replaying the ones from from_sequence on. A client that falls too far
+// behind has its stream aborted and resumes from the report after the last
+// one it received.
+func (server *MarketServer) StreamExecutionReports(req *marketv1.StreamExecutionReportsRequest, stream marketv1.MarketService_StreamExecutionReportsServer) error {
+	if server.Executions == nil {
+		return status.Error(codes.Unimplemented, "execution reports are not enabled")
+	}
+	if req.GetAccountId() == "" {
+		return status.Error(codes.InvalidArgument, "account_id is required")
+	}
+
+	replay, reports, unsubscribe, err := server.Executions.Subscribe(req.GetAccountId(), req.GetFromSequence())
+	if err != nil {
+		return accountError(err)
+	}
+	defer unsubscribe()
+
+	next := req.GetFromSequence()
+	for _, report := range replay {
+		if err := stream.Send(&marketv1.StreamExecutionReportsResponse{Report: executionReportToProto(report)}); err != nil {
+			return err
+		}
+		next = report.Sequence + 1
+	}
+
+	for {
+		select {
+		case report, open := <-reports:
+			if !open {
+				return status.Errorf(codes.Aborted, "stream fell behind; resume from sequence %d", next)
+			}
+
+			if err := stream.Send(&marketv1.StreamExecutionReportsResponse{Report: executionReportToProto(report)}); err != nil {
+				return err
+			}
+			next = report.Sequence + 1
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func executionTypeToProto(executionType string) marketv1.ExecutionType {
+	switch executionType {
+	case models.ExecutionNew:
+		return marketv1.ExecutionType_EXECUTION_TYPE_NEW
+	case models.ExecutionPartialFill:
+		return marketv1.ExecutionType_EXECUTION_TYPE_PARTIAL_FILL
+	case models.ExecutionFill:
+		return marketv1.ExecutionType_EXECUTION_TYPE_FILL
+	case models.ExecutionCancelled:
+		return marketv1.ExecutionType_EXECUTION_TYPE_CANCELLED
+	case models.ExecutionReplaced:
+		return marketv1.ExecutionType_EXECUTION_TYPE_REPLACED
+	case models.ExecutionRejected:
+		return marketv1.ExecutionType_EXECUTION_TYPE_REJECTED
+	case models.ExecutionExpired:
+		return marketv1.ExecutionType_EXECUTION_TYPE_EXPIRED
+	default:
+		return marketv1.ExecutionType_EXECUTION_TYPE_UNSPECIFIED
+	}
+}
+
+func executionReportToProto(report models.ExecutionReport) *marketv1.ExecutionReport {
+	return &marketv1.ExecutionReport{
+		Sequence:           report.Sequence,
+		Type:               executionTypeToProto(report.Type),
+		Timestamp:          report.Timestamp.UnixMilli(),
+		AccountId:          report.Account,
+		Order:              orderToProto(report.Order),
+		LastQuantity:       int64(report.LastQuantity),
+		LastPrice:          report.LastPrice,
+		TradeId:            report.TradeID,
+		Fees:               feesToProto(report.Fees),
+		CumulativeQuantity: int64(report.CumulativeQuantity),
+		LeavesQuantity:     int64(report.LeavesQuantity),
+		AveragePrice:       report.AveragePrice,
+		RejectReason:       rejectReasonToProto(report.RejectReason),
+		Text:               report.Text,
+	}
+}
+
+func rejectReasonToProto(reason string) marketv1.OrderRejectReason {
+	if reason == "" {
+		return marketv1.OrderRejectReason_ORDER_REJECT_REASON_UNSPECIFIED
+	}
+
+	return marketv1.OrderRejectReason(marketv1.OrderRejectReason_value["ORDER_REJECT_REASON_"+reason])
+}
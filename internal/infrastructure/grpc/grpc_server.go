@@ -4,34 +4,38 @@ import (
 	"context"
 	"log"
 	marketv1 "market-engine-go/gen/go/market/v1"
+	"market-engine-go/internal/infrastructure/accounts"
+	"market-engine-go/internal/infrastructure/auth"
+	"market-engine-go/internal/infrastructure/executions"
 	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/infrastructure/repository"
 	"time"
+
+	"google.golang.org/grpc/peer"
 )
 
 type MarketServer struct {
 	marketv1.UnimplementedMarketServiceServer
 	Engine *marketengine.MarketEngine
+	// Snapshots backs GetDailyHistory.
+	Snapshots repository.StockRepository
+	// Accounts are who orders are placed for.
+	Accounts *accounts.Ledger
+	// Executions report the life of accounts' orders.
+	Executions *executions.Service
 }
 
 func (server *MarketServer) GetTickers(ctx context.Context, req *marketv1.GetTickersRequest) (*marketv1.GetTickersResponse, error) {
-	var res []*marketv1.TickerData
-	for _, v := range server.Engine.Tickers {
-		current := v
-
-		lastPrice, exists := server.Engine.CurrentPrices[v.Symbol]
-
-		if exists {
-			current.Price = float64(lastPrice)
-		}
-
-		res = append(res, v)
-	}
-
-	return &marketv1.GetTickersResponse{Tickers: res}, nil
+	return &marketv1.GetTickersResponse{Tickers: server.Engine.TickerList()}, nil
 }
 
+// StreamTickers streams price updates for the symbols in the client's latest
+// request. Symbols listed by a reference data reload are announced to every
+// stream; a delisted symbol is announced to the streams subscribed to it
+// and its updates stop.
 func (server *MarketServer) StreamTickers(stream marketv1.MarketService_StreamTickersServer) error {
 	updateChannel := make(chan *marketv1.StreamTickersResponse, 100)
+	requestChannel := make(chan *marketv1.StreamTickersRequest)
 	activeGenerators := make(map[string]context.CancelFunc)
 
 	errChannel := make(chan error, 1)
@@ -39,7 +43,10 @@ func (server *MarketServer) StreamTickers(stream marketv1.MarketService_StreamTi
 	ctx, cancel := context.WithCancel(stream.Context())
 	defer cancel()
 
-	log.Printf("[StreamTickers] Client connected")
+	universe, unsubscribe := server.Engine.SubscribeUniverse()
+	defer unsubscribe()
+
+	log.Printf("[StreamTickers] %s connected", caller(ctx))
 
 	go func() {
 		for {
@@ -50,6 +57,29 @@ func (server *MarketServer) StreamTickers(stream marketv1.MarketService_StreamTi
 				return
 			}
 
+			select {
+			case requestChannel <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-errChannel:
+			for symbol, stop := range activeGenerators {
+				stop()
+				delete(activeGenerators, symbol)
+			}
+			cancel()
+
+			return nil
+		case <-ctx.Done():
+			log.Println("[StreamTickers] Client disconnected")
+
+			return nil
+		case req := <-requestChannel:
 			log.Printf("[StreamTickers] Processing %v", req.Symbols)
 			newSymbols := make(map[string]bool)
 			for _, s := range req.Symbols {
@@ -70,7 +100,7 @@ func (server *MarketServer) StreamTickers(stream marketv1.MarketService_StreamTi
 
 			for _, symbol := range req.Symbols {
 				if _, exists := activeGenerators[symbol]; !exists {
-					if _, ok := server.Engine.Tickers[symbol]; !ok {
+					if _, ok := server.Engine.Ticker(symbol); !ok {
 						log.Printf("[StreamTickers] Ticker unavailable: %v", symbol)
 						continue
 					}
@@ -80,24 +110,36 @@ func (server *MarketServer) StreamTickers(stream marketv1.MarketService_StreamTi
 					go server.Engine.RunPriceGenerator(tickerCtx, symbol, updateChannel)
 				}
 			}
-		}
-	}()
+		case change := <-universe:
+			timestamp := server.Engine.Now().UnixMilli()
 
-	for {
-		select {
-		case <-errChannel:
-			for symbol, stop := range activeGenerators {
+			for _, symbol := range change.Delisted {
+				stop, exists := activeGenerators[symbol]
+				if !exists {
+					continue
+				}
 				stop()
 				delete(activeGenerators, symbol)
-			}
-			cancel()
 
-			return nil
-		case <-ctx.Done():
-			log.Println("[StreamTickers] Client disconnected")
+				update := &marketv1.StreamTickersResponse{Symbol: symbol, Timestamp: timestamp, Status: marketv1.TickerStatus_TICKER_STATUS_DELISTED}
+				if err := stream.Send(update); err != nil {
+					log.Printf("[StreamTickers] Send failed: %v", err)
+					return err
+				}
+			}
 
-			return nil
+			for _, data := range change.Listed {
+				update := &marketv1.StreamTickersResponse{Symbol: data.Symbol, Name: data.Name, Price: data.Price, Timestamp: timestamp, Status: marketv1.TickerStatus_TICKER_STATUS_LISTED}
+				if err := stream.Send(update); err != nil {
+					log.Printf("[StreamTickers] Send failed: %v", err)
+					return err
+				}
+			}
 		case update := <-updateChannel:
+			if _, subscribed := activeGenerators[update.Symbol]; !subscribed {
+				continue
+			}
+
 			if err := stream.Send(update); err != nil {
 				log.Printf("[StreamTickers] Send failed: %v", err)
 				return err
@@ -115,7 +157,7 @@ func (server *MarketServer) StreamTrades(req *marketv1.StreamTradesRequest, stre
 	ticker := time.NewTicker(time.Duration(intervalMs) * time.Millisecond)
 	defer ticker.Stop()
 
-	log.Printf("[StreamTrades] Client connected: streaming every %v", req.GetIntervalMs())
+	log.Printf("[StreamTrades] %s connected: streaming every %v", caller(stream.Context()), req.GetIntervalMs())
 
 	for {
 		select {
@@ -149,3 +191,16 @@ func (server *MarketServer) StreamTrades(req *marketv1.StreamTradesRequest, stre
 		}
 	}
 }
+
+// caller names who made a call, for logs: the authenticated subject, or
+// the peer address when authentication is off.
+func caller(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.Subject
+	}
+	if client, ok := peer.FromContext(ctx); ok {
+		return "client " + client.Addr.String()
+	}
+
+	return "client"
+}
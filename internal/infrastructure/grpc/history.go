@@ -0,0 +1,173 @@
+package grpcserver
+
+import (
+	"context"
+	marketv1 "market-engine-go/gen/go/market/v1"
+	corporateactions "market-engine-go/internal/infrastructure/corporate-actions"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *MarketServer) GetDailyHistory(ctx context.Context, req *marketv1.GetDailyHistoryRequest) (*marketv1.GetDailyHistoryResponse, error) {
+	if server.Snapshots == nil {
+		return nil, status.Error(codes.Unavailable, "daily history is not configured")
+	}
+
+	if req.GetSymbol() == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+	}
+
+	from, err := parseHistoryDate(req.GetFromDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "from_date: %v", err)
+	}
+
+	to, err := parseHistoryDate(req.GetToDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "to_date: %v", err)
+	}
+
+	// Actions after the last requested day still adjust the days before
+	// it, and need the closes before their ex-dates, so adjusted history is
+	// read to the latest day and cut afterwards.
+	until := to
+	if req.GetAdjusted() {
+		until = time.Time{}
+	}
+
+	bars, err := server.Snapshots.History(req.GetSymbol(), from, until)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	actions, err := server.Snapshots.CorporateActions(req.GetSymbol(), time.Time{}, server.Engine.Now())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if req.GetAdjusted() {
+		bars = corporateactions.AdjustBars(bars, actions)
+		if !to.IsZero() {
+			bars = slices.DeleteFunc(bars, func(bar models.DailyBar) bool {
+				return bar.Date.After(to)
+			})
+		}
+	}
+
+	if len(bars) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no daily history for %s", req.GetSymbol())
+	}
+
+	if limit := int(req.GetLimit()); limit > 0 && len(bars) > limit {
+		bars = bars[len(bars)-limit:]
+	}
+
+	res := &marketv1.GetDailyHistoryResponse{
+		Symbol:   req.GetSymbol(),
+		Name:     bars[len(bars)-1].Name,
+		Adjusted: req.GetAdjusted(),
+	}
+	for _, bar := range bars {
+		res.Bars = append(res.Bars, &marketv1.DailyBar{
+			Date:      bar.Date.Format("2006-01-02"),
+			High:      bar.High,
+			Low:       bar.Low,
+			Close:     bar.Close,
+			Change:    bar.Change,
+			Volume:    bar.Volume,
+			Value:     bar.Value,
+			Frequency: bar.Frequency,
+		})
+	}
+
+	applied := server.Engine.CorporateActions()
+	for _, action := range actions {
+		if action.ExDate.After(bars[0].Date) {
+			res.CorporateActions = append(res.CorporateActions, corporateActionToProto(action, applied))
+		}
+	}
+
+	return res, nil
+}
+
+func (server *MarketServer) ListCorporateActions(ctx context.Context, req *marketv1.ListCorporateActionsRequest) (*marketv1.ListCorporateActionsResponse, error) {
+	if server.Snapshots == nil {
+		return nil, status.Error(codes.Unavailable, "corporate actions are not configured")
+	}
+
+	from, err := parseHistoryDate(req.GetFromDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "from_date: %v", err)
+	}
+
+	to, err := parseHistoryDate(req.GetToDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "to_date: %v", err)
+	}
+
+	actions, err := server.Snapshots.CorporateActions(req.GetSymbol(), from, to)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &marketv1.ListCorporateActionsResponse{}
+	applied := server.Engine.CorporateActions()
+	for _, action := range actions {
+		res.CorporateActions = append(res.CorporateActions, corporateActionToProto(action, applied))
+	}
+
+	return res, nil
+}
+
+func corporateActionToProto(action models.CorporateAction, applied []models.CorporateAction) *marketv1.CorporateAction {
+	return &marketv1.CorporateAction{
+		Symbol:      action.Code,
+		Type:        corporateActionTypeToProto(action.Type),
+		ExDate:      formatHistoryDate(action.ExDate),
+		RecordDate:  formatHistoryDate(action.RecordDate),
+		PaymentDate: formatHistoryDate(action.PaymentDate),
+		Amount:      action.Amount,
+		Ratio:       action.Ratio,
+		Price:       action.Price,
+		Description: action.Description,
+		Applied: slices.ContainsFunc(applied, func(other models.CorporateAction) bool {
+			return other.Code == action.Code && other.Type == action.Type && other.ExDate.Equal(action.ExDate)
+		}),
+	}
+}
+
+func corporateActionTypeToProto(actionType string) marketv1.CorporateActionType {
+	switch actionType {
+	case models.CorporateActionDividend:
+		return marketv1.CorporateActionType_CORPORATE_ACTION_TYPE_DIVIDEND
+	case models.CorporateActionSplit:
+		return marketv1.CorporateActionType_CORPORATE_ACTION_TYPE_SPLIT
+	case models.CorporateActionReverseSplit:
+		return marketv1.CorporateActionType_CORPORATE_ACTION_TYPE_REVERSE_SPLIT
+	case models.CorporateActionRightsIssue:
+		return marketv1.CorporateActionType_CORPORATE_ACTION_TYPE_RIGHTS_ISSUE
+	default:
+		return marketv1.CorporateActionType_CORPORATE_ACTION_TYPE_UNSPECIFIED
+	}
+}
+
+func formatHistoryDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format("2006-01-02")
+}
+
+func parseHistoryDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return repository.ParseSnapshotDate(value)
+}
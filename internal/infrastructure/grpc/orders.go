@@ -0,0 +1,269 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	marketv1 "market-engine-go/gen/go/market/v1"
+	"market-engine-go/internal/infrastructure/accounts"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/infrastructure/risk"
+	"market-engine-go/internal/models"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *MarketServer) PlaceOrder(ctx context.Context, req *marketv1.PlaceOrderRequest) (*marketv1.PlaceOrderResponse, error) {
+	if req.GetAccountId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+	if _, err := server.Accounts.Account(req.GetAccountId()); err != nil {
+		return nil, accountError(err)
+	}
+
+	placed := models.Order{
+		Owner:       req.GetAccountId(),
+		Ticker:      req.GetSymbol(),
+		Side:        sideFromProto(req.GetSide()),
+		Type:        orderTypeFromProto(req.GetType()),
+		Price:       req.GetPrice(),
+		Quantity:    int(req.GetQuantity()),
+		TimeInForce: timeInForceFromProto(req.GetTimeInForce()),
+	}
+	order, trades, err := server.Engine.SubmitOrder(placed)
+	if err != nil {
+		if server.Executions != nil {
+			reason, _, _ := rejectReason(err)
+			server.Executions.Reject(placed, reason, err.Error())
+		}
+		return nil, engineError(err)
+	}
+
+	res := &marketv1.PlaceOrderResponse{Order: orderToProto(order)}
+	for _, trade := range trades {
+		confirmation := tradeToProto(trade)
+		if order.Side == models.SideBuy {
+			confirmation.Fees = feesToProto(trade.BuyerFees)
+		} else {
+			confirmation.Fees = feesToProto(trade.SellerFees)
+		}
+		res.Trades = append(res.Trades, confirmation)
+	}
+
+	return res, nil
+}
+
+func (server *MarketServer) CancelOrder(ctx context.Context, req *marketv1.CancelOrderRequest) (*marketv1.CancelOrderResponse, error) {
+	order, err := server.Engine.CancelOrder(req.GetOrderId())
+	if err != nil {
+		return nil, engineError(err)
+	}
+
+	return &marketv1.CancelOrderResponse{Order: orderToProto(order)}, nil
+}
+
+func (server *MarketServer) GetOrderBook(ctx context.Context, req *marketv1.GetOrderBookRequest) (*marketv1.GetOrderBookResponse, error) {
+	book, err := server.Engine.OrderBook(req.GetSymbol(), int(req.GetDepth()))
+	if err != nil {
+		return nil, engineError(err)
+	}
+
+	return &marketv1.GetOrderBookResponse{
+		Symbol: book.Ticker,
+		Bids:   levelsToProto(book.Bids),
+		Asks:   levelsToProto(book.Asks),
+	}, nil
+}
+
+// rejectReasonDomain is the ErrorInfo domain of order rejections.
+const rejectReasonDomain = "market.v1"
+
+func engineError(err error) error {
+	if reason, code, ok := rejectReason(err); ok {
+		return rejectionError(code, reason, err)
+	}
+
+	switch {
+	case errors.Is(err, marketengine.ErrUnknownSymbol), errors.Is(err, marketengine.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, marketengine.ErrInvalidOrder):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// rejectReason is the OrderRejectReason name of an error that rejected an
+// order and the code it is returned with; ok is false for other errors.
+func rejectReason(err error) (reason string, code codes.Code, ok bool) {
+	var rejection *risk.Rejection
+	switch {
+	case errors.As(err, &rejection):
+		if rejection.Reason == risk.ReasonRateLimit {
+			return rejection.Reason, codes.ResourceExhausted, true
+		}
+		return rejection.Reason, codes.FailedPrecondition, true
+	case errors.Is(err, accounts.ErrInsufficientBuyingPower):
+		return "INSUFFICIENT_BUYING_POWER", codes.FailedPrecondition, true
+	case errors.Is(err, accounts.ErrInsufficientShares):
+		return "INSUFFICIENT_SHARES", codes.FailedPrecondition, true
+	case errors.Is(err, marketengine.ErrSymbolHalted):
+		return "SYMBOL_HALTED", codes.FailedPrecondition, true
+	case errors.Is(err, accounts.ErrMarginCall):
+		return "MARGIN_CALL", codes.FailedPrecondition, true
+	default:
+		return "", codes.OK, false
+	}
+}
+
+// rejectionError reports a rejected order with an ErrorInfo detail naming
+// its OrderRejectReason.
+func rejectionError(code codes.Code, reason string, err error) error {
+	rejected := status.New(code, err.Error())
+	detailed, detailErr := rejected.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: rejectReasonDomain})
+	if detailErr != nil {
+		return rejected.Err()
+	}
+
+	return detailed.Err()
+}
+
+func sideFromProto(side marketv1.OrderSide) string {
+	switch side {
+	case marketv1.OrderSide_ORDER_SIDE_BUY:
+		return models.SideBuy
+	case marketv1.OrderSide_ORDER_SIDE_SELL:
+		return models.SideSell
+	default:
+		return ""
+	}
+}
+
+func sideToProto(side string) marketv1.OrderSide {
+	switch side {
+	case models.SideBuy:
+		return marketv1.OrderSide_ORDER_SIDE_BUY
+	case models.SideSell:
+		return marketv1.OrderSide_ORDER_SIDE_SELL
+	default:
+		return marketv1.OrderSide_ORDER_SIDE_UNSPECIFIED
+	}
+}
+
+func orderTypeFromProto(orderType marketv1.OrderType) string {
+	switch orderType {
+	case marketv1.OrderType_ORDER_TYPE_LIMIT:
+		return models.OrderTypeLimit
+	case marketv1.OrderType_ORDER_TYPE_MARKET:
+		return models.OrderTypeMarket
+	default:
+		return ""
+	}
+}
+
+func orderTypeToProto(orderType string) marketv1.OrderType {
+	switch orderType {
+	case models.OrderTypeLimit:
+		return marketv1.OrderType_ORDER_TYPE_LIMIT
+	case models.OrderTypeMarket:
+		return marketv1.OrderType_ORDER_TYPE_MARKET
+	default:
+		return marketv1.OrderType_ORDER_TYPE_UNSPECIFIED
+	}
+}
+
+func orderStatusToProto(orderStatus string) marketv1.OrderStatus {
+	switch orderStatus {
+	case models.OrderStatusNew:
+		return marketv1.OrderStatus_ORDER_STATUS_NEW
+	case models.OrderStatusPartiallyFilled:
+		return marketv1.OrderStatus_ORDER_STATUS_PARTIALLY_FILLED
+	case models.OrderStatusFilled:
+		return marketv1.OrderStatus_ORDER_STATUS_FILLED
+	case models.OrderStatusCancelled:
+		return marketv1.OrderStatus_ORDER_STATUS_CANCELLED
+	case models.OrderStatusExpired:
+		return marketv1.OrderStatus_ORDER_STATUS_EXPIRED
+	case models.OrderStatusRejected:
+		return marketv1.OrderStatus_ORDER_STATUS_REJECTED
+	default:
+		return marketv1.OrderStatus_ORDER_STATUS_UNSPECIFIED
+	}
+}
+
+func timeInForceFromProto(timeInForce marketv1.TimeInForce) string {
+	switch timeInForce {
+	case marketv1.TimeInForce_TIME_IN_FORCE_UNSPECIFIED, marketv1.TimeInForce_TIME_IN_FORCE_GTC:
+		return models.TimeInForceGTC
+	case marketv1.TimeInForce_TIME_IN_FORCE_DAY:
+		return models.TimeInForceDay
+	default:
+		return timeInForce.String()
+	}
+}
+
+func timeInForceToProto(timeInForce string) marketv1.TimeInForce {
+	switch timeInForce {
+	case models.TimeInForceDay:
+		return marketv1.TimeInForce_TIME_IN_FORCE_DAY
+	default:
+		return marketv1.TimeInForce_TIME_IN_FORCE_GTC
+	}
+}
+
+func orderToProto(order models.Order) *marketv1.Order {
+	return &marketv1.Order{
+		Id:             order.ID,
+		Symbol:         order.Ticker,
+		Side:           sideToProto(order.Side),
+		Type:           orderTypeToProto(order.Type),
+		Price:          order.Price,
+		Quantity:       int64(order.Quantity),
+		FilledQuantity: int64(order.Filled),
+		Status:         orderStatusToProto(order.Status),
+		CreatedAt:      order.CreatedAt.UnixMilli(),
+		UpdatedAt:      order.UpdatedAt.UnixMilli(),
+		Liquidation:    order.Liquidation,
+		TimeInForce:    timeInForceToProto(order.TimeInForce),
+	}
+}
+
+func tradeToProto(trade models.Trade) *marketv1.Trade {
+	return &marketv1.Trade{
+		Id:        trade.ID,
+		Symbol:    trade.Ticker,
+		Price:     trade.Price,
+		Quantity:  int64(trade.Size),
+		Side:      sideToProto(trade.Side),
+		Timestamp: trade.Timestamp.UnixMilli(),
+	}
+}
+
+func feesToProto(fees *models.Fees) *marketv1.Fees {
+	if fees == nil {
+		return nil
+	}
+
+	return &marketv1.Fees{
+		Commission: fees.Commission,
+		Levy:       fees.Levy,
+		Clearing:   fees.Clearing,
+		Vat:        fees.VAT,
+		SalesTax:   fees.SalesTax,
+		Total:      fees.Total(),
+	}
+}
+
+func levelsToProto(levels []models.PriceLevel) []*marketv1.PriceLevel {
+	res := make([]*marketv1.PriceLevel, 0, len(levels))
+	for _, level := range levels {
+		res = append(res, &marketv1.PriceLevel{
+			Price:     level.Price,
+			Volume:    int64(level.Volume),
+			Frequency: int32(level.Frequency),
+		})
+	}
+
+	return res
+}
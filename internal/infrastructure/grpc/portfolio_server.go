@@ -0,0 +1,75 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	marketv1 "market-engine-go/gen/go/market/v1"
+	"market-engine-go/internal/infrastructure/accounts"
+	"market-engine-go/internal/infrastructure/portfolio"
+	"market-engine-go/internal/models"
+	"time"
+)
+
+type PortfolioServer struct {
+	marketv1.UnimplementedPortfolioServiceServer
+	Ledger     *accounts.Ledger
+	Portfolios *portfolio.Service
+	// DefaultInterval is the least time between stream updates when a
+	// request sets none, and MinInterval the least a request can set.
+	DefaultInterval time.Duration
+	MinInterval     time.Duration
+}
+
+func (server *PortfolioServer) GetPortfolio(ctx context.Context, req *marketv1.GetPortfolioRequest) (*marketv1.GetPortfolioResponse, error) {
+	account, err := server.Ledger.Account(req.GetAccountId())
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	return &marketv1.GetPortfolioResponse{Portfolio: portfolioToProto(server.Portfolios.Value(account))}, nil
+}
+
+func (server *PortfolioServer) StreamPortfolio(req *marketv1.StreamPortfolioRequest, stream marketv1.PortfolioService_StreamPortfolioServer) error {
+	interval := server.DefaultInterval
+	if req.GetIntervalMs() > 0 {
+		interval = max(time.Duration(req.GetIntervalMs())*time.Millisecond, server.MinInterval)
+	}
+
+	err := server.Portfolios.Watch(stream.Context(), req.GetAccountId(), interval, func(valuation models.Portfolio) error {
+		return stream.Send(&marketv1.StreamPortfolioResponse{Portfolio: portfolioToProto(valuation)})
+	})
+	if errors.Is(err, accounts.ErrUnknownAccount) {
+		return accountError(err)
+	}
+
+	return err
+}
+
+func portfolioToProto(valuation models.Portfolio) *marketv1.Portfolio {
+	res := &marketv1.Portfolio{
+		AccountId:     valuation.AccountID,
+		Timestamp:     valuation.Timestamp.UnixMilli(),
+		Cash:          valuation.Cash,
+		MarketValue:   valuation.MarketValue,
+		Equity:        valuation.Equity,
+		RealizedPnl:   valuation.RealizedPnL,
+		UnrealizedPnl: valuation.UnrealizedPnL,
+	}
+
+	for _, position := range valuation.Positions {
+		if position.Quantity != 0 {
+			res.Positions = append(res.Positions, positionToProto(position))
+		}
+	}
+
+	for _, sector := range valuation.Sectors {
+		res.Sectors = append(res.Sectors, &marketv1.SectorExposure{
+			Sector:        sector.Sector,
+			MarketValue:   sector.MarketValue,
+			UnrealizedPnl: sector.UnrealizedPnL,
+			Weight:        sector.Weight,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,338 @@
+package journal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"market-engine-go/internal/models"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// FsyncAlways syncs every event to disk before the engine continues.
+	FsyncAlways = "always"
+	// FsyncInterval flushes and syncs buffered events periodically.
+	FsyncInterval = "interval"
+	// FsyncNever flushes periodically and leaves syncing to the OS.
+	FsyncNever = "never"
+)
+
+type Options struct {
+	Fsync         string
+	FsyncInterval time.Duration
+	// KeepSnapshots is how many snapshots are retained. Journal segments
+	// fully covered by the oldest retained snapshot are deleted.
+	KeepSnapshots int
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Fsync:         FsyncInterval,
+		FsyncInterval: time.Second,
+		KeepSnapshots: 2,
+	}
+}
+
+// Snapshotter is the part of the engine the journal needs for snapshots.
+type Snapshotter interface {
+	Snapshot() models.EngineSnapshot
+}
+
+// Journal is an append-only log of engine events stored as JSON Lines
+// segments next to periodic snapshots in a plain directory. Each process
+// starts a new segment, so a torn write from a crash is never appended to.
+type Journal struct {
+	dir     string
+	options Options
+
+	mu     sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	dirty  bool
+	failed bool
+	closed bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func Open(dir string, options Options) (*Journal, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	switch options.Fsync {
+	case FsyncAlways, FsyncInterval, FsyncNever:
+	default:
+		return nil, fmt.Errorf("unknown fsync policy %q", options.Fsync)
+	}
+
+	if options.FsyncInterval <= 0 {
+		options.FsyncInterval = time.Second
+	}
+	if options.KeepSnapshots <= 0 {
+		options.KeepSnapshots = 1
+	}
+
+	journal := &Journal{
+		dir:     dir,
+		options: options,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go journal.flushLoop()
+
+	return journal, nil
+}
+
+// Append writes one event. It is meant to be registered as an engine event
+// listener, so failures are logged rather than returned.
+func (journal *Journal) Append(event models.Event) {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	if journal.closed {
+		return
+	}
+
+	if err := journal.append(event); err != nil {
+		if !journal.failed {
+			log.Printf("[Journal] Append failed, events are no longer durable: %v", err)
+		}
+		journal.failed = true
+	}
+}
+
+func (journal *Journal) append(event models.Event) error {
+	if journal.file == nil {
+		path := filepath.Join(journal.dir, segmentName(event.Sequence))
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+
+		journal.file = file
+		journal.writer = bufio.NewWriter(file)
+	}
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if _, err := journal.writer.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	journal.dirty = true
+
+	if journal.options.Fsync == FsyncAlways {
+		return journal.flush(true)
+	}
+
+	return nil
+}
+
+// flush expects the caller to hold journal.mu.
+func (journal *Journal) flush(sync bool) error {
+	if journal.file == nil || !journal.dirty {
+		return nil
+	}
+
+	if err := journal.writer.Flush(); err != nil {
+		return err
+	}
+
+	journal.dirty = false
+
+	if sync {
+		return journal.file.Sync()
+	}
+
+	return nil
+}
+
+func (journal *Journal) flushLoop() {
+	defer close(journal.done)
+
+	ticker := time.NewTicker(journal.options.FsyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-journal.stop:
+			return
+		case <-ticker.C:
+			journal.mu.Lock()
+			err := journal.flush(journal.options.Fsync != FsyncNever)
+			journal.mu.Unlock()
+
+			if err != nil {
+				log.Printf("[Journal] Flush failed: %v", err)
+			}
+		}
+	}
+}
+
+// Sync flushes buffered events and syncs the current segment to disk.
+func (journal *Journal) Sync() error {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	return journal.flush(true)
+}
+
+func (journal *Journal) Close() error {
+	close(journal.stop)
+	<-journal.done
+
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	journal.closed = true
+	return journal.closeSegment()
+}
+
+// closeSegment expects the caller to hold journal.mu.
+func (journal *Journal) closeSegment() error {
+	if journal.file == nil {
+		return nil
+	}
+
+	flushErr := journal.flush(true)
+	closeErr := journal.file.Close()
+	journal.file = nil
+	journal.writer = nil
+
+	if flushErr != nil {
+		return flushErr
+	}
+
+	return closeErr
+}
+
+// WriteSnapshot stores the snapshot atomically, starts a new journal segment
+// and prunes snapshots and segments that are no longer needed.
+func (journal *Journal) WriteSnapshot(snapshot models.EngineSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFileAtomic(journal.dir, snapshotName(snapshot.Sequence), data); err != nil {
+		return err
+	}
+
+	journal.mu.Lock()
+	err = journal.closeSegment()
+	journal.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return journal.prune()
+}
+
+// RunSnapshots writes a snapshot of the engine every interval until ctx is
+// cancelled.
+func (journal *Journal) RunSnapshots(ctx context.Context, engine Snapshotter, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastSequence uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			snapshot := engine.Snapshot()
+			if snapshot.Sequence == lastSequence {
+				continue
+			}
+
+			if err := journal.WriteSnapshot(snapshot); err != nil {
+				log.Printf("[Journal] Snapshot failed: %v", err)
+				continue
+			}
+
+			lastSequence = snapshot.Sequence
+		}
+	}
+}
+
+func (journal *Journal) prune() error {
+	snapshots, err := listSequences(journal.dir, snapshotPattern)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) <= journal.options.KeepSnapshots {
+		return nil
+	}
+
+	for _, sequence := range snapshots[:len(snapshots)-journal.options.KeepSnapshots] {
+		if err := os.Remove(filepath.Join(journal.dir, snapshotName(sequence))); err != nil {
+			return err
+		}
+	}
+
+	oldestKept := snapshots[len(snapshots)-journal.options.KeepSnapshots]
+
+	segments, err := listSequences(journal.dir, segmentPattern)
+	if err != nil {
+		return err
+	}
+
+	// A segment holds the events up to the first sequence of the next one.
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i+1]-1 > oldestKept {
+			break
+		}
+
+		if err := os.Remove(filepath.Join(journal.dir, segmentName(segments[i]))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeFileAtomic(dir string, name string, data []byte) error {
+	temp, err := os.CreateTemp(dir, name+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return err
+	}
+
+	if err := temp.Sync(); err != nil {
+		temp.Close()
+		return err
+	}
+
+	if err := temp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(temp.Name(), filepath.Join(dir, name)); err != nil {
+		return err
+	}
+
+	directory, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer directory.Close()
+
+	return directory.Sync()
+}
@@ -0,0 +1,170 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"market-engine-go/internal/models"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+var (
+	segmentPattern  = regexp.MustCompile(`^journal-(\d{20})\.jsonl$`)
+	snapshotPattern = regexp.MustCompile(`^snapshot-(\d{20})\.json$`)
+)
+
+func segmentName(firstSequence uint64) string {
+	return fmt.Sprintf("journal-%020d.jsonl", firstSequence)
+}
+
+func snapshotName(sequence uint64) string {
+	return fmt.Sprintf("snapshot-%020d.json", sequence)
+}
+
+// Recovery is what a journal directory holds: the latest readable snapshot,
+// if any, and every event recorded after it.
+type Recovery struct {
+	Snapshot *models.EngineSnapshot
+	Events   []models.Event
+}
+
+// Recover reads the journal directory. A missing directory is an empty
+// journal, not an error.
+func Recover(dir string) (Recovery, error) {
+	var recovery Recovery
+
+	snapshots, err := listSequences(dir, snapshotPattern)
+	if err != nil {
+		return recovery, err
+	}
+
+	// Fall back to an older snapshot if the newest one cannot be read.
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		snapshot, err := readSnapshot(filepath.Join(dir, snapshotName(snapshots[i])))
+		if err != nil {
+			log.Printf("[Journal] Skipping unreadable snapshot %d: %v", snapshots[i], err)
+			continue
+		}
+
+		recovery.Snapshot = snapshot
+		break
+	}
+
+	var after uint64
+	if recovery.Snapshot != nil {
+		after = recovery.Snapshot.Sequence
+	}
+
+	recovery.Events, err = ReadEvents(dir, after)
+	return recovery, err
+}
+
+// ReadEvents returns the journalled events with a sequence number above
+// after, in order. Lines torn by a crash are skipped.
+func ReadEvents(dir string, after uint64) ([]models.Event, error) {
+	segments, err := listSequences(dir, segmentPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []models.Event
+	last := after
+	for i, first := range segments {
+		// Skip segments that end before the requested sequence.
+		if i+1 < len(segments) && segments[i+1]-1 <= after {
+			continue
+		}
+
+		path := filepath.Join(dir, segmentName(first))
+		err := readSegment(path, func(event models.Event) {
+			if event.Sequence <= last {
+				return
+			}
+
+			if event.Sequence != last+1 {
+				log.Printf("[Journal] Gap in %s: expected %d, found %d", path, last+1, event.Sequence)
+			}
+
+			events = append(events, event)
+			last = event.Sequence
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func readSegment(path string, handle func(models.Event)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		var event models.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			log.Printf("[Journal] Skipping %s line %d: %v", path, line, err)
+			continue
+		}
+
+		handle(event)
+	}
+
+	return scanner.Err()
+}
+
+func readSnapshot(path string) (*models.EngineSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot models.EngineSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+func listSequences(dir string, pattern *regexp.Regexp) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var sequences []uint64
+	for _, entry := range entries {
+		match := pattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		sequence, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	slices.Sort(sequences)
+	return sequences, nil
+}
@@ -0,0 +1,85 @@
+package marketengine
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock supplies the engine's notion of the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SimulatedClock runs from a chosen start time at a multiple of wall-clock
+// speed, so a trading day can be replayed faster or slower than real time.
+type SimulatedClock struct {
+	start  time.Time
+	origin time.Time
+	speed  float64
+}
+
+func NewSimulatedClock(start time.Time, speed float64) *SimulatedClock {
+	if speed <= 0 {
+		speed = 1
+	}
+
+	return &SimulatedClock{start: start, origin: time.Now(), speed: speed}
+}
+
+func (clock *SimulatedClock) Now() time.Time {
+	elapsed := time.Since(clock.origin)
+	return clock.start.Add(time.Duration(float64(elapsed) * clock.speed))
+}
+
+func (clock *SimulatedClock) Speed() float64 {
+	return clock.speed
+}
+
+// ManualClock only moves when it is set, for example by a replay following
+// the timestamps of a recorded tape.
+type ManualClock struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+func (clock *ManualClock) Now() time.Time {
+	clock.mu.RLock()
+	defer clock.mu.RUnlock()
+
+	return clock.now
+}
+
+func (clock *ManualClock) Set(now time.Time) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	clock.now = now
+}
+
+func (engine *MarketEngine) SetClock(clock Clock) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.clock = clock
+}
+
+func (engine *MarketEngine) Clock() Clock {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	return engine.clock
+}
+
+func (engine *MarketEngine) Now() time.Time {
+	return engine.Clock().Now()
+}
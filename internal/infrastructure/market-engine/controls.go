@@ -0,0 +1,94 @@
+package marketengine
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrSymbolHalted = errors.New("symbol is halted")
+
+// Halt stops order entry for a symbol until the given engine time. A zero
+// time halts it until Resume is called.
+func (engine *MarketEngine) Halt(ticker string, until time.Time) error {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if _, exists := engine.Tickers[ticker]; !exists {
+		return ErrUnknownSymbol
+	}
+
+	engine.halted[ticker] = until
+	return nil
+}
+
+func (engine *MarketEngine) Resume(ticker string) error {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if _, exists := engine.Tickers[ticker]; !exists {
+		return ErrUnknownSymbol
+	}
+
+	delete(engine.halted, ticker)
+	return nil
+}
+
+func (engine *MarketEngine) IsHalted(ticker string) bool {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	return engine.isHalted(ticker)
+}
+
+// isHalted expects the caller to hold engine.Mu.
+func (engine *MarketEngine) isHalted(ticker string) bool {
+	until, exists := engine.halted[ticker]
+	if !exists {
+		return false
+	}
+
+	return until.IsZero() || engine.clock.Now().Before(until)
+}
+
+// SetFundamental moves the value that value-driven participants anchor to.
+func (engine *MarketEngine) SetFundamental(ticker string, price float64) error {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if _, exists := engine.Tickers[ticker]; !exists {
+		return ErrUnknownSymbol
+	}
+
+	engine.fundamentals[ticker] = price
+	return nil
+}
+
+// Volatility returns the multiplier simulated participants apply to their
+// price ranges for a symbol. It is 1 unless a scenario has changed it.
+func (engine *MarketEngine) Volatility(ticker string) float64 {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	if multiplier, exists := engine.volatility[ticker]; exists {
+		return multiplier
+	}
+
+	return 1
+}
+
+func (engine *MarketEngine) SetVolatility(ticker string, multiplier float64) error {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if _, exists := engine.Tickers[ticker]; !exists {
+		return ErrUnknownSymbol
+	}
+
+	if multiplier <= 0 || multiplier == 1 {
+		delete(engine.volatility, ticker)
+		return nil
+	}
+
+	engine.volatility[ticker] = multiplier
+	return nil
+}
@@ -0,0 +1,187 @@
+package marketengine
+
+import (
+	"cmp"
+	"log"
+	"maps"
+	"market-engine-go/internal/models"
+	"math"
+	"slices"
+	"strings"
+
+	marketv1 "market-engine-go/gen/go/market/v1"
+)
+
+// ApplyCorporateActions puts into effect the actions whose ex-date has come
+// since the reference snapshot was taken, and returns those it applied.
+// Actions already applied, for unlisted symbols or dated on or before the
+// reference snapshot are skipped, so the same list can be passed again.
+//
+// An applied action moves the symbol to its new units: the reference, last
+// and fundamental prices are multiplied by the action's price factor, and
+// resting orders have their price scaled the same way (buys rounded down
+// and sells up to the tick grid) and their quantity by the split ratio
+// (rounded down to whole lots). Orders left without a whole lot are
+// cancelled.
+func (engine *MarketEngine) ApplyCorporateActions(actions []models.CorporateAction) []models.CorporateAction {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if engine.referenceDate.IsZero() {
+		return nil
+	}
+
+	today := engine.clock.Now()
+	pending := slices.SortedFunc(slices.Values(actions), compareCorporateActions)
+
+	var applied []models.CorporateAction
+	for _, action := range pending {
+		if _, done := engine.appliedActions[corporateActionKey(action)]; done {
+			continue
+		}
+		if !action.ExDate.After(engine.referenceDate) || action.ExDate.After(today) {
+			continue
+		}
+		if _, listed := engine.Tickers[action.Code]; !listed {
+			continue
+		}
+
+		engine.applyCorporateAction(action)
+		applied = append(applied, action)
+	}
+
+	return applied
+}
+
+// applyCorporateAction adjusts a symbol for one action and journals it. The
+// caller must hold engine.Mu.
+func (engine *MarketEngine) applyCorporateAction(action models.CorporateAction) {
+	recorded := action
+	engine.emit(models.Event{Type: models.EventCorporateAction, Ticker: action.Code, CorporateAction: &recorded})
+
+	factor := engine.adjustReference(action)
+	volumeFactor := action.VolumeFactor()
+
+	if last, exists := engine.CurrentPrices[action.Code]; exists {
+		engine.CurrentPrices[action.Code] = RoundToTick(last * factor)
+	}
+	if fundamental, exists := engine.fundamentals[action.Code]; exists {
+		engine.fundamentals[action.Code] = fundamental * factor
+	}
+
+	adjusted, cancelled := 0, 0
+	if book, exists := engine.orderBooks[action.Code]; exists {
+		// Orders are adjusted in book priority, so orders that end up at
+		// the same price keep their relative priority.
+		for _, order := range slices.Concat(book.bids, book.asks) {
+			if engine.adjustOrder(order, factor, volumeFactor) {
+				adjusted++
+			} else {
+				cancelled++
+			}
+		}
+	}
+
+	price, exists := engine.CurrentPrices[action.Code]
+	if !exists {
+		price = engine.Tickers[action.Code].Price
+		engine.CurrentPrices[action.Code] = price
+	}
+	engine.emit(models.Event{Type: models.EventPriceUpdate, Ticker: action.Code, Price: price})
+
+	log.Printf("[Engine] Applied %s %s effective %s: price factor %.4f, %d orders adjusted, %d cancelled",
+		action.Code, action.Type, action.ExDate.Format("2006-01-02"), factor, adjusted, cancelled)
+}
+
+// adjustReference marks an action applied and moves the symbol's reference
+// price by its price factor, which it returns. The caller must hold
+// engine.Mu.
+func (engine *MarketEngine) adjustReference(action models.CorporateAction) float64 {
+	engine.appliedActions[corporateActionKey(action)] = action
+
+	data, exists := engine.Tickers[action.Code]
+	if !exists {
+		return 1
+	}
+
+	factor := action.PriceFactor(data.Price)
+	engine.Tickers[action.Code] = &marketv1.TickerData{
+		Symbol: data.Symbol,
+		Name:   data.Name,
+		Price:  RoundToTick(data.Price * factor),
+	}
+
+	return factor
+}
+
+// adjustOrder moves a resting order to the symbol's new units, or cancels
+// it when no whole lot would remain. It reports whether the order is still
+// resting. The caller must hold engine.Mu.
+func (engine *MarketEngine) adjustOrder(order *models.Order, factor float64, volumeFactor float64) bool {
+	price := order.Price * factor
+	if order.Side == models.SideBuy {
+		price = RoundDownToTick(price)
+	} else {
+		price = RoundUpToTick(price)
+	}
+
+	remaining := int(math.Floor(float64(order.Remaining())*volumeFactor/models.LotSize)) * models.LotSize
+	if price <= 0 || remaining <= 0 {
+		engine.cancel(order)
+		return false
+	}
+
+	book := engine.bookFor(order.Ticker)
+	book.remove(order)
+
+	order.Filled = int(math.Round(float64(order.Filled) * volumeFactor))
+	order.Quantity = order.Filled + remaining
+	order.Price = price
+	order.UpdatedAt = engine.clock.Now()
+	book.insert(order)
+
+	adjusted := *order
+	engine.emit(models.Event{Type: models.EventOrderAdjusted, Timestamp: order.UpdatedAt, Order: &adjusted})
+
+	return true
+}
+
+// reapplyCorporateActions moves freshly loaded reference prices to the units
+// of the actions already applied since the reference snapshot, and forgets
+// the actions the snapshot already reflects. Resting orders were adjusted
+// when the actions were first applied and are left alone. The caller must
+// hold engine.Mu.
+func (engine *MarketEngine) reapplyCorporateActions() {
+	for _, action := range engine.appliedCorporateActions() {
+		if !action.ExDate.After(engine.referenceDate) {
+			delete(engine.appliedActions, corporateActionKey(action))
+			continue
+		}
+
+		engine.adjustReference(action)
+		if data, exists := engine.Tickers[action.Code]; exists {
+			engine.CurrentPrices[action.Code] = data.Price
+		}
+	}
+}
+
+// CorporateActions returns the actions applied since the reference
+// snapshot, by ex-date.
+func (engine *MarketEngine) CorporateActions() []models.CorporateAction {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	return engine.appliedCorporateActions()
+}
+
+func (engine *MarketEngine) appliedCorporateActions() []models.CorporateAction {
+	return slices.SortedFunc(maps.Values(engine.appliedActions), compareCorporateActions)
+}
+
+func compareCorporateActions(a models.CorporateAction, b models.CorporateAction) int {
+	return cmp.Or(a.ExDate.Compare(b.ExDate), strings.Compare(a.Code, b.Code), strings.Compare(a.Type, b.Type))
+}
+
+func corporateActionKey(action models.CorporateAction) string {
+	return action.Code + "|" + action.Type + "|" + action.ExDate.Format("2006-01-02")
+}
@@ -0,0 +1,201 @@
+package marketengine
+
+import (
+	"maps"
+	"market-engine-go/internal/models"
+	"slices"
+	"time"
+)
+
+// EventListener receives every engine event while the engine lock is held,
+// so it must not call back into the engine.
+type EventListener func(event models.Event)
+
+func (engine *MarketEngine) AddEventListener(listener EventListener) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.eventListeners = append(engine.eventListeners, listener)
+}
+
+// emit stamps the event with the next sequence number and hands it to the
+// listeners. The caller must hold engine.Mu.
+func (engine *MarketEngine) emit(event models.Event) {
+	engine.eventSequence++
+	event.Sequence = engine.eventSequence
+	if event.Timestamp.IsZero() {
+		event.Timestamp = engine.clock.Now()
+	}
+
+	if engine.ledger != nil {
+		engine.ledger.Apply(event, false)
+	}
+
+	for _, listener := range engine.eventListeners {
+		listener(event)
+	}
+}
+
+// Snapshot captures the state needed to rebuild the engine, tagged with the
+// sequence number of the last event it includes.
+func (engine *MarketEngine) Snapshot() models.EngineSnapshot {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	snapshot := models.EngineSnapshot{
+		Sequence:      engine.eventSequence,
+		TakenAt:       engine.clock.Now(),
+		OrderSequence: engine.orderSequence,
+		TradeSequence: engine.tradeSequence,
+		Trades:        slices.Clone(engine.Trades),
+		LastPrices:    maps.Clone(engine.CurrentPrices),
+		Fundamentals:  maps.Clone(engine.fundamentals),
+
+		CorporateActions: engine.appliedCorporateActions(),
+	}
+
+	if engine.ledger != nil {
+		snapshot.Accounts = engine.ledger.Snapshot()
+	}
+
+	for _, ticker := range slices.Sorted(maps.Keys(engine.orderBooks)) {
+		book := engine.orderBooks[ticker]
+		for _, order := range book.bids {
+			snapshot.Orders = append(snapshot.Orders, *order)
+		}
+		for _, order := range book.asks {
+			snapshot.Orders = append(snapshot.Orders, *order)
+		}
+	}
+
+	return snapshot
+}
+
+// Restore rebuilds the engine from a snapshot, which may be nil, followed by
+// the events journalled after it. Listeners are not notified.
+func (engine *MarketEngine) Restore(snapshot *models.EngineSnapshot, events []models.Event) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if snapshot != nil {
+		engine.eventSequence = snapshot.Sequence
+		engine.orderSequence = snapshot.OrderSequence
+		engine.tradeSequence = snapshot.TradeSequence
+		engine.Trades = slices.Clone(snapshot.Trades)
+		engine.orderBooks = make(map[string]*orderBook)
+		engine.orders = make(map[string]*models.Order)
+
+		if snapshot.LastPrices != nil {
+			engine.CurrentPrices = maps.Clone(snapshot.LastPrices)
+		}
+		if snapshot.Fundamentals != nil {
+			engine.fundamentals = maps.Clone(snapshot.Fundamentals)
+		}
+
+		for _, order := range snapshot.Orders {
+			engine.rest(order)
+		}
+
+		if engine.ledger != nil {
+			engine.ledger.Restore(snapshot.Accounts, snapshot.Orders)
+		}
+
+		// Recorded prices and orders are already adjusted; the reference
+		// prices just loaded from the daily snapshot are not.
+		for _, action := range snapshot.CorporateActions {
+			engine.restoreCorporateAction(action)
+		}
+	}
+
+	for _, event := range events {
+		if event.Sequence <= engine.eventSequence {
+			continue
+		}
+
+		engine.apply(event)
+		if engine.ledger != nil {
+			engine.ledger.Apply(event, true)
+		}
+		engine.eventSequence = event.Sequence
+	}
+}
+
+func (engine *MarketEngine) apply(event models.Event) {
+	switch event.Type {
+	case models.EventOrderAccepted:
+		engine.orderSequence++
+		if event.Order != nil && event.Order.IsOpen() && event.Order.Type == models.OrderTypeLimit {
+			engine.rest(*event.Order)
+		}
+	case models.EventOrderCancelled, models.EventOrderExpired:
+		if event.Order == nil {
+			return
+		}
+		if resting, exists := engine.orders[event.Order.ID]; exists {
+			engine.bookFor(resting.Ticker).remove(resting)
+			delete(engine.orders, resting.ID)
+		}
+	case models.EventTrade:
+		if event.Trade == nil {
+			return
+		}
+		engine.tradeSequence++
+		engine.fillResting(event.Trade.BuyOrderID, event.Trade.Size, event.Timestamp)
+		engine.fillResting(event.Trade.SellOrderID, event.Trade.Size, event.Timestamp)
+		engine.appendTrade(*event.Trade)
+	case models.EventPriceUpdate:
+		engine.CurrentPrices[event.Ticker] = event.Price
+	case models.EventCorporateAction:
+		if event.CorporateAction != nil {
+			engine.restoreCorporateAction(*event.CorporateAction)
+		}
+	case models.EventOrderAdjusted:
+		if event.Order == nil {
+			return
+		}
+		if resting, exists := engine.orders[event.Order.ID]; exists {
+			book := engine.bookFor(resting.Ticker)
+			book.remove(resting)
+			*resting = *event.Order
+			book.insert(resting)
+		}
+	}
+}
+
+// restoreCorporateAction marks a recovered action applied and adjusts the
+// reference price for it, unless the reference snapshot was taken after
+// the action and already reflects it.
+func (engine *MarketEngine) restoreCorporateAction(action models.CorporateAction) {
+	if engine.referenceDate.IsZero() || !action.ExDate.After(engine.referenceDate) {
+		return
+	}
+
+	engine.adjustReference(action)
+}
+
+// fillResting replays a trade against a resting order. Incoming orders are
+// not resting yet when their trades are replayed and are skipped here.
+func (engine *MarketEngine) fillResting(orderID string, size int, at time.Time) {
+	resting, exists := engine.orders[orderID]
+	if !exists {
+		return
+	}
+
+	resting.Filled += size
+	resting.UpdatedAt = at
+
+	if resting.Remaining() > 0 {
+		resting.Status = models.OrderStatusPartiallyFilled
+		return
+	}
+
+	resting.Status = models.OrderStatusFilled
+	engine.bookFor(resting.Ticker).remove(resting)
+	delete(engine.orders, orderID)
+}
+
+func (engine *MarketEngine) rest(order models.Order) {
+	resting := order
+	engine.bookFor(resting.Ticker).insert(&resting)
+	engine.orders[resting.ID] = &resting
+}
@@ -0,0 +1,87 @@
+package marketengine
+
+import (
+	"market-engine-go/internal/models"
+)
+
+// Ledger keeps client accounts in step with the engine. Every method is
+// called with the engine lock held, so a ledger must not call back into the
+// engine; the checks are given a view of it instead.
+type Ledger interface {
+	// CheckOrder is called before an order is accepted.
+	CheckOrder(order models.Order, market MarketView) error
+	// ChargeFees fills in the fees a trade's buyer and seller pay, before
+	// the trade is recorded.
+	ChargeFees(trade *models.Trade)
+	// CheckEvent is called before RecordEvent records an event.
+	CheckEvent(event models.Event, market MarketView) error
+	// Apply is called with every event, as it happens and when replayed
+	// from the journal.
+	Apply(event models.Event, replayed bool)
+	// Snapshot returns the accounts for an engine snapshot.
+	Snapshot() []models.Account
+	// Restore replaces the accounts with those of a snapshot; orders are
+	// the resting orders restored with them.
+	Restore(accounts []models.Account, orders []models.Order)
+}
+
+// SetLedger attaches the ledger client orders are checked and settled
+// against. It must be set before the engine is restored from a journal.
+func (engine *MarketEngine) SetLedger(ledger Ledger) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.ledger = ledger
+}
+
+// RecordEvent journals an event raised outside the order book, such as an
+// account being funded. The ledger checks it first and applies it; an event
+// it rejects is not recorded.
+func (engine *MarketEngine) RecordEvent(event models.Event) (models.Event, error) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if engine.ledger != nil {
+		if err := engine.ledger.CheckEvent(event, lockedView{engine}); err != nil {
+			return event, err
+		}
+	}
+
+	event.Timestamp = engine.clock.Now()
+	engine.emit(event)
+
+	return event, nil
+}
+
+// orderCost is the cash an order would take at its limit price, or for a
+// market order what it would pay sweeping the current book; quantity the
+// book cannot fill is cancelled, so it costs nothing. The caller must hold
+// engine.Mu.
+func (engine *MarketEngine) orderCost(order models.Order) float64 {
+	if order.Type == models.OrderTypeLimit {
+		return order.Price * float64(order.Quantity)
+	}
+
+	book, exists := engine.orderBooks[order.Ticker]
+	if !exists {
+		return 0
+	}
+
+	opposite := book.asks
+	if order.Side == models.SideSell {
+		opposite = book.bids
+	}
+
+	cost, remaining := 0.0, order.Quantity
+	for _, resting := range opposite {
+		if remaining == 0 {
+			break
+		}
+
+		size := min(remaining, resting.Remaining())
+		cost += resting.Price * float64(size)
+		remaining -= size
+	}
+
+	return cost
+}
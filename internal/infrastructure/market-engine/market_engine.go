@@ -2,15 +2,12 @@ package marketengine
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"maps"
 	"market-engine-go/internal/infrastructure/repository"
 	"market-engine-go/internal/models"
-	"market-engine-go/internal/utils"
-	"math"
-	"math/rand/v2"
 	"slices"
+	"strconv"
 	"sync"
 	"time"
 
@@ -20,15 +17,40 @@ import (
 )
 
 type MarketEngine struct {
-	orderBooks    map[string]*models.OrderBook
-	Trades        []models.Trade
-	Mu            sync.RWMutex
-	Tickers       map[string]*marketv1.TickerData
-	TradeChannel  chan models.Trade
-	CurrentPrices map[string]float64
+	orderBooks   map[string]*orderBook
+	orders       map[string]*models.Order
+	fundamentals map[string]float64
+	volatility   map[string]float64
+	halted       map[string]time.Time
+	clock        Clock
+	// run prefixes the order IDs this process assigns. The order sequence
+	// starts over when the engine runs without its journal, while the
+	// execution history outlives the process, so IDs must not repeat.
+	run                 string
+	orderSequence       uint64
+	tradeSequence       uint64
+	tradeListeners      []TradeListener
+	eventSequence       uint64
+	eventListeners      []EventListener
+	universeSequence    uint64
+	universeSubscribers map[uint64]chan UniverseChange
+	Trades              []models.Trade
+	Mu                  sync.RWMutex
+	Tickers             map[string]*marketv1.TickerData
+	TradeChannel        chan models.Trade
+	CurrentPrices       map[string]float64
+	referenceDate       time.Time
+	// appliedActions are the corporate actions applied since the reference
+	// snapshot, by corporateActionKey.
+	appliedActions map[string]models.CorporateAction
+	ledger         Ledger
+	preTradeCheck  PreTradeCheck
 }
 
-func New() *MarketEngine {
+// New loads reference prices from the repository's snapshot for the given day,
+// or its latest snapshot when the date is zero. Without a snapshot the
+// engine falls back to a built-in set of large caps.
+func New(store repository.StockRepository, date time.Time) *MarketEngine {
 	dummy := map[string]*marketv1.TickerData{
 		"BBCA": {Symbol: "BBCA", Price: 8150, Name: "Bank Central Asia Tbk"},
 		"BBRI": {Symbol: "BBRI", Price: 3800, Name: "Bank Rakyat Indonesia (Persero) Tbk"},
@@ -53,138 +75,118 @@ func New() *MarketEngine {
 		"BRPT": {Symbol: "BRPT", Price: 3510, Name: "Barito Pacific Tbk"},
 	}
 
-	stocksRepository := repository.NewCsvStockRepository("./output")
-	stocks, err := stocksRepository.ReadStockSnapshotCsv("stocks_idx_22_12_2025.csv")
+	referenceDate, stocks, err := store.Load(date)
 
 	if err == nil {
-		dummyStocks := make(map[string]*marketv1.TickerData)
-		for _, stock := range stocks {
-			price, err := utils.ParseStockFloat(stock.Close)
-			if err != nil {
-				continue
-			}
-
-			if price < 50 {
-				continue
-			}
-
-			dummyStocks[stock.Code] = &marketv1.TickerData{
-				Symbol: stock.Code,
-				Name:   stock.Name,
-				Price:  price,
-			}
-		}
-		dummy = dummyStocks
+		dummy = referenceTickers(stocks)
 
+		log.Printf("Loaded %d symbols from the %s snapshot", len(dummy), referenceDate.Format("2006-01-02"))
 	} else {
 		log.Printf("Error reading from csv, using default dummy: %v", err)
 	}
 
 	engine := &MarketEngine{
-		Trades:        make([]models.Trade, 0, 1000),
-		TradeChannel:  make(chan models.Trade, 100),
-		CurrentPrices: make(map[string]float64),
-		Tickers:       dummy,
+		orderBooks:          make(map[string]*orderBook),
+		orders:              make(map[string]*models.Order),
+		fundamentals:        make(map[string]float64),
+		volatility:          make(map[string]float64),
+		halted:              make(map[string]time.Time),
+		universeSubscribers: make(map[uint64]chan UniverseChange),
+		appliedActions:      make(map[string]models.CorporateAction),
+		clock:               SystemClock{},
+		run:                 strconv.FormatInt(time.Now().UnixMilli(), 36),
+		Trades:              make([]models.Trade, 0, 1000),
+		TradeChannel:        make(chan models.Trade, 100),
+		CurrentPrices:       make(map[string]float64),
+		Tickers:             dummy,
+		referenceDate:       referenceDate,
 	}
 
 	return engine
 }
 
-func (engine *MarketEngine) StartSimulation() {
-	tickerChannel := time.NewTicker(5 * time.Millisecond)
+// ReferenceDate is the day of the snapshot the reference prices came from;
+// it is zero when the built-in set is used.
+func (engine *MarketEngine) ReferenceDate() time.Time {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
 
-	go func() {
-		for range tickerChannel.C {
-			engine.updateTrades()
-		}
-	}()
+	return engine.referenceDate
 }
 
-func (engine *MarketEngine) updateTrades() {
-	engine.Mu.Lock()
-	defer engine.Mu.Unlock()
-
-	symbols := slices.Collect(maps.Keys(engine.Tickers))
-	symbol := symbols[rand.IntN(len(symbols))]
-	basePrice := engine.Tickers[symbol].Price
-
-	// Randomize price slightly (+/- 0.5%)
-	change := math.Floor((rand.Float64() - 0.5) * (basePrice * 0.01))
-	tradePrice := basePrice + change
-
-	newTrade := models.Trade{
-		ID:        fmt.Sprintf("TRD-%d", time.Now().UnixNano()),
-		Ticker:    symbol,
-		Price:     tradePrice,
-		Size:      rand.IntN(1000) + 1,
-		Side:      []string{"BUY", "SELL"}[rand.IntN(2)],
-		Timestamp: time.Now(),
-	}
+// Symbols returns every symbol with reference data loaded in the engine.
+func (engine *MarketEngine) Symbols() []string {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
 
-	runningTrades := engine.Trades
-	if len(runningTrades) >= 1000 {
-		engine.Trades = runningTrades[1:]
-	}
+	return slices.Sorted(maps.Keys(engine.Tickers))
+}
 
-	engine.Trades = append(runningTrades, newTrade)
+// recordTrade appends the trade to the rolling tape and notifies listeners.
+// The caller must hold engine.Mu.
+func (engine *MarketEngine) recordTrade(trade models.Trade) {
+	engine.appendTrade(trade)
+
+	recorded := trade
+	engine.emit(models.Event{Type: models.EventTrade, Timestamp: trade.Timestamp, Trade: &recorded})
 
 	select {
-	case engine.TradeChannel <- newTrade:
+	case engine.TradeChannel <- trade:
 	default:
 	}
+
+	for _, listener := range engine.tradeListeners {
+		listener(trade)
+	}
+}
+
+func (engine *MarketEngine) appendTrade(trade models.Trade) {
+	runningTrades := engine.Trades
+	if len(runningTrades) >= 1000 {
+		runningTrades = runningTrades[1:]
+	}
+
+	engine.Trades = append(runningTrades, trade)
 }
 
+// RunPriceGenerator streams a symbol's last traded price to the channel
+// whenever order flow moves it.
 func (engine *MarketEngine) RunPriceGenerator(ctx context.Context, symbol string, channel chan<- *marketv1.StreamTickersResponse) {
-	for {
-		interval := time.Duration(100+rand.IntN(5000-100)) * time.Millisecond
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	lastSent, _ := engine.LastPrice(symbol)
 
+	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(interval):
-			updated := engine.calculateNextPrice(symbol)
+		case <-ticker.C:
+			updated := engine.nextPriceUpdate(symbol, lastSent)
+			if updated == nil {
+				continue
+			}
 
-			if updated != nil {
-				channel <- updated
+			select {
+			case channel <- updated:
+				lastSent = updated.Price
+			case <-ctx.Done():
+				return
 			}
 		}
 	}
 }
 
-func (engine *MarketEngine) calculateNextPrice(symbol string) *marketv1.StreamTickersResponse {
-	lastPrice, exists := engine.CurrentPrices[symbol]
-	if !exists {
-		lastPrice = engine.Tickers[symbol].Price
-	}
-
-	// TODO: Make it using the fraction system
-	var changeAmount int
-	if lastPrice < 200 {
-		changeAmount = (rand.IntN(4) - 1)
-	} else if lastPrice >= 200 && lastPrice <= 500 {
-		changeAmount = (rand.IntN(4) - 1) * 2
-	} else if lastPrice >= 500 && lastPrice <= 2000 {
-		changeAmount = (rand.IntN(4) - 1) * 5
-	} else if lastPrice >= 2000 && lastPrice <= 5000 {
-		changeAmount = (rand.IntN(4) - 1) * 10
-	} else {
-		changeAmount = (rand.IntN(4) - 1) * 25
-	}
-
-	newPrice := lastPrice + float64(changeAmount)
-
-	if newPrice < 50 {
+func (engine *MarketEngine) nextPriceUpdate(symbol string, lastSent float64) *marketv1.StreamTickersResponse {
+	price, exists := engine.LastPrice(symbol)
+	if !exists || price == lastSent {
 		return nil
 	}
 
-	engine.CurrentPrices[symbol] = newPrice
-
-	updated := &marketv1.StreamTickersResponse{
+	return &marketv1.StreamTickersResponse{
 		Symbol:    symbol,
-		Price:     float64(newPrice),
-		Change:    wrapperspb.Int32(int32(changeAmount)),
-		Timestamp: time.Now().UnixMilli(),
+		Price:     price,
+		Change:    wrapperspb.Int32(int32(price - lastSent)),
+		Timestamp: engine.Now().UnixMilli(),
 	}
-
-	return updated
 }
@@ -0,0 +1,178 @@
+package marketengine
+
+import (
+	"fmt"
+	"market-engine-go/internal/models"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// orderBook keeps resting limit orders for one symbol in price-time priority.
+// Bids are sorted by descending price and asks by ascending price; orders at
+// the same price keep their arrival order.
+type orderBook struct {
+	bids []*models.Order
+	asks []*models.Order
+}
+
+func (book *orderBook) side(side string) *[]*models.Order {
+	if side == models.SideBuy {
+		return &book.bids
+	}
+
+	return &book.asks
+}
+
+func (book *orderBook) insert(order *models.Order) {
+	orders := book.side(order.Side)
+
+	index, _ := slices.BinarySearchFunc(*orders, order, func(resting, incoming *models.Order) int {
+		if resting.Price == incoming.Price {
+			// Place the incoming order after every resting order at its price.
+			return -1
+		}
+
+		if incoming.Side == models.SideBuy {
+			if resting.Price > incoming.Price {
+				return -1
+			}
+			return 1
+		}
+
+		if resting.Price < incoming.Price {
+			return -1
+		}
+		return 1
+	})
+
+	*orders = slices.Insert(*orders, index, order)
+}
+
+func (book *orderBook) remove(order *models.Order) bool {
+	orders := book.side(order.Side)
+
+	index := slices.Index(*orders, order)
+	if index == -1 {
+		return false
+	}
+
+	*orders = slices.Delete(*orders, index, index+1)
+	return true
+}
+
+func crosses(incoming *models.Order, resting *models.Order) bool {
+	if incoming.Type == models.OrderTypeMarket {
+		return true
+	}
+
+	if incoming.Side == models.SideBuy {
+		return resting.Price <= incoming.Price
+	}
+
+	return resting.Price >= incoming.Price
+}
+
+// match executes the incoming order against the opposite side of the book at
+// the resting orders' prices and returns the resulting trades. Fully filled
+// resting orders are removed from the book and passed to onFilled.
+func (book *orderBook) match(incoming *models.Order, now time.Time, nextTradeID func() string, onFilled func(*models.Order)) []models.Trade {
+	opposite := book.side(models.SideSell)
+	if incoming.Side == models.SideSell {
+		opposite = book.side(models.SideBuy)
+	}
+
+	var trades []models.Trade
+	for incoming.Remaining() > 0 && len(*opposite) > 0 {
+		resting := (*opposite)[0]
+		if !crosses(incoming, resting) {
+			break
+		}
+
+		size := min(incoming.Remaining(), resting.Remaining())
+		incoming.Filled += size
+		resting.Filled += size
+		incoming.UpdatedAt = now
+		resting.UpdatedAt = now
+
+		trade := models.Trade{
+			ID:        nextTradeID(),
+			Ticker:    incoming.Ticker,
+			Price:     resting.Price,
+			Size:      size,
+			Side:      incoming.Side,
+			Timestamp: now,
+		}
+
+		if incoming.Side == models.SideBuy {
+			trade.BuyOrderID, trade.Buyer = incoming.ID, incoming.Owner
+			trade.SellOrderID, trade.Seller = resting.ID, resting.Owner
+		} else {
+			trade.BuyOrderID, trade.Buyer = resting.ID, resting.Owner
+			trade.SellOrderID, trade.Seller = incoming.ID, incoming.Owner
+		}
+
+		trades = append(trades, trade)
+
+		if resting.Remaining() == 0 {
+			resting.Status = models.OrderStatusFilled
+			*opposite = (*opposite)[1:]
+			onFilled(resting)
+		} else {
+			resting.Status = models.OrderStatusPartiallyFilled
+		}
+	}
+
+	switch {
+	case incoming.Remaining() == 0:
+		incoming.Status = models.OrderStatusFilled
+	case incoming.Filled > 0:
+		incoming.Status = models.OrderStatusPartiallyFilled
+	}
+
+	return trades
+}
+
+func aggregateLevels(orders []*models.Order, depth int) []models.PriceLevel {
+	var levels []models.PriceLevel
+	for _, order := range orders {
+		count := len(levels)
+		if count > 0 && levels[count-1].Price == order.Price {
+			levels[count-1].Volume += order.Remaining()
+			levels[count-1].Frequency++
+			continue
+		}
+
+		if depth > 0 && count == depth {
+			break
+		}
+
+		levels = append(levels, models.PriceLevel{
+			Price:     order.Price,
+			Volume:    order.Remaining(),
+			Frequency: 1,
+		})
+	}
+
+	return levels
+}
+
+func (book *orderBook) snapshot(ticker string, depth int) models.OrderBook {
+	return models.OrderBook{
+		Ticker: ticker,
+		Bids:   aggregateLevels(book.bids, depth),
+		Asks:   aggregateLevels(book.asks, depth),
+	}
+}
+
+func newOrderID(run string, sequence uint64) string {
+	return fmt.Sprintf("ORD-%s-%d", run, sequence)
+}
+
+// orderSequence returns the sequence an order ID was assigned with, for
+// IDs with or without a run prefix.
+func orderSequence(id string) uint64 {
+	sequence, _ := strconv.ParseUint(id[strings.LastIndex(id, "-")+1:], 10, 64)
+	return sequence
+}
@@ -0,0 +1,272 @@
+package marketengine
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"market-engine-go/internal/models"
+	"slices"
+)
+
+var (
+	ErrUnknownSymbol = errors.New("unknown symbol")
+	ErrInvalidOrder  = errors.New("invalid order")
+	ErrOrderNotFound = errors.New("order not found")
+)
+
+// TradeListener is notified of every trade while the engine lock is held, so
+// it must not call back into the engine.
+type TradeListener func(trade models.Trade)
+
+func (engine *MarketEngine) AddTradeListener(listener TradeListener) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.tradeListeners = append(engine.tradeListeners, listener)
+}
+
+// SubmitOrder validates the order, matches it against the book and rests any
+// unfilled limit quantity. Unfilled market quantity is cancelled.
+func (engine *MarketEngine) SubmitOrder(order models.Order) (models.Order, []models.Trade, error) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	if err := engine.validateOrder(order); err != nil {
+		return order, nil, err
+	}
+
+	var cancel []string
+	if engine.preTradeCheck != nil {
+		var err error
+		if cancel, err = engine.preTradeCheck(order, lockedView{engine}); err != nil {
+			return order, nil, err
+		}
+	}
+
+	if engine.ledger != nil {
+		if err := engine.ledger.CheckOrder(order, lockedView{engine}); err != nil {
+			return order, nil, err
+		}
+	}
+
+	for _, orderID := range cancel {
+		if resting, exists := engine.orders[orderID]; exists {
+			engine.cancel(resting)
+		}
+	}
+
+	now := engine.clock.Now()
+	engine.orderSequence++
+	order.ID = newOrderID(engine.run, engine.orderSequence)
+	order.Filled = 0
+	order.Status = models.OrderStatusNew
+	order.CreatedAt = now
+	order.UpdatedAt = now
+
+	book := engine.bookFor(order.Ticker)
+	trades := book.match(&order, now, engine.nextTradeID, func(filled *models.Order) {
+		delete(engine.orders, filled.ID)
+	})
+
+	for i := range trades {
+		if engine.ledger != nil {
+			engine.ledger.ChargeFees(&trades[i])
+		}
+		engine.recordTrade(trades[i])
+	}
+
+	if len(trades) > 0 {
+		lastPrice := trades[len(trades)-1].Price
+		engine.CurrentPrices[order.Ticker] = lastPrice
+		engine.emit(models.Event{Type: models.EventPriceUpdate, Timestamp: now, Ticker: order.Ticker, Price: lastPrice})
+	}
+
+	if order.Remaining() > 0 {
+		if order.Type == models.OrderTypeLimit {
+			engine.rest(order)
+		} else {
+			order.Status = models.OrderStatusCancelled
+		}
+	}
+
+	accepted := order
+	engine.emit(models.Event{Type: models.EventOrderAccepted, Timestamp: now, Order: &accepted})
+
+	return order, trades, nil
+}
+
+func (engine *MarketEngine) CancelOrder(orderID string) (models.Order, error) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	order, exists := engine.orders[orderID]
+	if !exists {
+		return models.Order{}, ErrOrderNotFound
+	}
+
+	return engine.cancel(order), nil
+}
+
+// CancelOwned cancels every resting order of an owner, for example quotes a
+// simulated participant left behind before a restart.
+func (engine *MarketEngine) CancelOwned(owner string) int {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	var owned []*models.Order
+	for _, order := range engine.orders {
+		if order.Owner == owner {
+			owned = append(owned, order)
+		}
+	}
+
+	for _, order := range owned {
+		engine.cancel(order)
+	}
+
+	return len(owned)
+}
+
+// ExpireOrders expires the resting orders expired reports true for, such
+// as day orders after the close they were good for, and returns how many
+// it expired. Expired is called with the engine lock held.
+func (engine *MarketEngine) ExpireOrders(expired func(order models.Order) bool) int {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	var due []*models.Order
+	for _, order := range engine.orders {
+		if expired(*order) {
+			due = append(due, order)
+		}
+	}
+	slices.SortFunc(due, func(a *models.Order, b *models.Order) int {
+		if byTime := a.CreatedAt.Compare(b.CreatedAt); byTime != 0 {
+			return byTime
+		}
+		return cmp.Compare(orderSequence(a.ID), orderSequence(b.ID))
+	})
+
+	for _, order := range due {
+		engine.remove(order, models.OrderStatusExpired, models.EventOrderExpired)
+	}
+
+	return len(due)
+}
+
+// cancel removes a resting order from its book. The caller must hold
+// engine.Mu.
+func (engine *MarketEngine) cancel(order *models.Order) models.Order {
+	return engine.remove(order, models.OrderStatusCancelled, models.EventOrderCancelled)
+}
+
+// remove takes a resting order off its book with a final status and
+// records the event that does it. The caller must hold engine.Mu.
+func (engine *MarketEngine) remove(order *models.Order, status string, eventType string) models.Order {
+	engine.bookFor(order.Ticker).remove(order)
+	delete(engine.orders, order.ID)
+
+	order.Status = status
+	order.UpdatedAt = engine.clock.Now()
+
+	removed := *order
+	engine.emit(models.Event{Type: eventType, Timestamp: order.UpdatedAt, Order: &removed})
+
+	return removed
+}
+
+// OrderBook returns the aggregated depth for a symbol. A depth of zero returns
+// every price level.
+func (engine *MarketEngine) OrderBook(ticker string, depth int) (models.OrderBook, error) {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	if _, exists := engine.Tickers[ticker]; !exists {
+		return models.OrderBook{}, ErrUnknownSymbol
+	}
+
+	book, exists := engine.orderBooks[ticker]
+	if !exists {
+		return models.OrderBook{Ticker: ticker}, nil
+	}
+
+	return book.snapshot(ticker, depth), nil
+}
+
+// LastPrice returns the symbol's last traded price, falling back to the
+// reference price before it has traded.
+func (engine *MarketEngine) LastPrice(ticker string) (float64, bool) {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	return lockedView{engine}.LastPrice(ticker)
+}
+
+// Fundamental returns the value that value-driven participants anchor to. It
+// starts at the reference price loaded with the instrument.
+func (engine *MarketEngine) Fundamental(ticker string) (float64, bool) {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	if price, exists := engine.fundamentals[ticker]; exists {
+		return price, true
+	}
+
+	data, exists := engine.Tickers[ticker]
+	if !exists {
+		return 0, false
+	}
+
+	return data.Price, true
+}
+
+func (engine *MarketEngine) validateOrder(order models.Order) error {
+	if _, exists := engine.Tickers[order.Ticker]; !exists {
+		return ErrUnknownSymbol
+	}
+
+	if engine.isHalted(order.Ticker) {
+		return ErrSymbolHalted
+	}
+
+	if order.Side != models.SideBuy && order.Side != models.SideSell {
+		return fmt.Errorf("%w: side must be %s or %s", ErrInvalidOrder, models.SideBuy, models.SideSell)
+	}
+
+	if order.Quantity <= 0 || order.Quantity%models.LotSize != 0 {
+		return fmt.Errorf("%w: quantity must be a positive multiple of %d", ErrInvalidOrder, models.LotSize)
+	}
+
+	switch order.Type {
+	case models.OrderTypeMarket:
+	case models.OrderTypeLimit:
+		if !IsValidTick(order.Price) {
+			return fmt.Errorf("%w: price %v is not on the tick grid", ErrInvalidOrder, order.Price)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported order type %q", ErrInvalidOrder, order.Type)
+	}
+
+	switch order.TimeInForce {
+	case "", models.TimeInForceGTC, models.TimeInForceDay:
+	default:
+		return fmt.Errorf("%w: unsupported time in force %q", ErrInvalidOrder, order.TimeInForce)
+	}
+
+	return nil
+}
+
+func (engine *MarketEngine) bookFor(ticker string) *orderBook {
+	book, exists := engine.orderBooks[ticker]
+	if !exists {
+		book = &orderBook{}
+		engine.orderBooks[ticker] = book
+	}
+
+	return book
+}
+
+func (engine *MarketEngine) nextTradeID() string {
+	engine.tradeSequence++
+	return fmt.Sprintf("TRD-%d-%d", engine.clock.Now().UnixNano(), engine.tradeSequence)
+}
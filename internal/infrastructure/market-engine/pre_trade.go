@@ -0,0 +1,106 @@
+package marketengine
+
+import (
+	"market-engine-go/internal/models"
+	"time"
+)
+
+// PreTradeCheck vets an order after it has been validated and before it is
+// checked against its account and matched. It is called with the engine
+// lock held, so it must not call back into the engine; market is a view of
+// the engine for the length of the call. Cancel lists resting orders the
+// engine cancels before the order is matched, which is how self-trade
+// prevention can clear the way for it.
+type PreTradeCheck func(order models.Order, market MarketView) (cancel []string, err error)
+
+// MarketView is what a pre-trade check sees of the engine.
+type MarketView interface {
+	Now() time.Time
+	// LastPrice falls back to the reference price before a symbol trades.
+	LastPrice(symbol string) (float64, bool)
+	// ReferencePrice is the previous close, which the day's moves are
+	// measured from.
+	ReferencePrice(symbol string) (float64, bool)
+	// Cost is the order's cash value at its limit price or, for a market
+	// order, at the prices it would fill at in the current book.
+	Cost(order models.Order) float64
+	// Crossing returns the resting orders the order would trade against in
+	// the current book, in the order it would meet them.
+	Crossing(order models.Order) []models.Order
+	// OpenOrders returns an owner's resting orders.
+	OpenOrders(owner string) []models.Order
+}
+
+// SetPreTradeCheck installs the check every submitted order passes through.
+func (engine *MarketEngine) SetPreTradeCheck(check PreTradeCheck) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.preTradeCheck = check
+}
+
+// lockedView is the MarketView of an engine whose lock the caller holds.
+type lockedView struct {
+	engine *MarketEngine
+}
+
+func (view lockedView) Now() time.Time {
+	return view.engine.clock.Now()
+}
+
+func (view lockedView) LastPrice(symbol string) (float64, bool) {
+	if price, exists := view.engine.CurrentPrices[symbol]; exists {
+		return price, true
+	}
+
+	return view.ReferencePrice(symbol)
+}
+
+func (view lockedView) ReferencePrice(symbol string) (float64, bool) {
+	data, exists := view.engine.Tickers[symbol]
+	if !exists {
+		return 0, false
+	}
+
+	return data.Price, true
+}
+
+func (view lockedView) Cost(order models.Order) float64 {
+	return view.engine.orderCost(order)
+}
+
+func (view lockedView) Crossing(order models.Order) []models.Order {
+	book, exists := view.engine.orderBooks[order.Ticker]
+	if !exists {
+		return nil
+	}
+
+	opposite := book.asks
+	if order.Side == models.SideSell {
+		opposite = book.bids
+	}
+
+	var crossing []models.Order
+	remaining := order.Quantity
+	for _, resting := range opposite {
+		if remaining <= 0 || !crosses(&order, resting) {
+			break
+		}
+
+		crossing = append(crossing, *resting)
+		remaining -= resting.Remaining()
+	}
+
+	return crossing
+}
+
+func (view lockedView) OpenOrders(owner string) []models.Order {
+	var open []models.Order
+	for _, order := range view.engine.orders {
+		if order.Owner == owner {
+			open = append(open, *order)
+		}
+	}
+
+	return open
+}
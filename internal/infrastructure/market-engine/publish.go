@@ -0,0 +1,57 @@
+package marketengine
+
+import (
+	"maps"
+	"market-engine-go/internal/models"
+
+	marketv1 "market-engine-go/gen/go/market/v1"
+)
+
+// PublishTrade puts a trade that did not come from the book, such as one
+// read from a recorded tape, on the engine's trade feed and moves the last
+// price to it.
+func (engine *MarketEngine) PublishTrade(trade models.Trade) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.listTicker(trade.Ticker, trade.Price)
+	engine.recordTrade(trade)
+	engine.CurrentPrices[trade.Ticker] = trade.Price
+	engine.emit(models.Event{Type: models.EventPriceUpdate, Timestamp: trade.Timestamp, Ticker: trade.Ticker, Price: trade.Price})
+}
+
+// PublishPrice moves a symbol's last price without a trade.
+func (engine *MarketEngine) PublishPrice(ticker string, price float64) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.listTicker(ticker, price)
+	engine.CurrentPrices[ticker] = price
+	engine.emit(models.Event{Type: models.EventPriceUpdate, Ticker: ticker, Price: price})
+}
+
+// ResetMarketData clears the trade feed and replaces every last price, so a
+// replay can jump to another point of its tape.
+func (engine *MarketEngine) ResetMarketData(lastPrices map[string]float64) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.Trades = engine.Trades[:0]
+	engine.CurrentPrices = maps.Clone(lastPrices)
+	if engine.CurrentPrices == nil {
+		engine.CurrentPrices = make(map[string]float64)
+	}
+}
+
+// listTicker adds reference data for a symbol the engine has not loaded, so
+// clients can subscribe to it and are told it is listed. The caller must
+// hold engine.Mu.
+func (engine *MarketEngine) listTicker(ticker string, price float64) {
+	if _, exists := engine.Tickers[ticker]; exists {
+		return
+	}
+
+	data := &marketv1.TickerData{Symbol: ticker, Name: ticker, Price: price}
+	engine.Tickers[ticker] = data
+	engine.notifyUniverse(UniverseChange{Listed: []*marketv1.TickerData{data}})
+}
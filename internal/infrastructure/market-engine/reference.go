@@ -0,0 +1,207 @@
+package marketengine
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"slices"
+	"time"
+
+	marketv1 "market-engine-go/gen/go/market/v1"
+)
+
+// minReferencePrice leaves out stocks trading below the IDX floor price,
+// which cannot be quoted in whole ticks.
+const minReferencePrice = 50
+
+func referenceTickers(stocks []models.DailyBar) map[string]*marketv1.TickerData {
+	tickers := make(map[string]*marketv1.TickerData)
+	for _, stock := range stocks {
+		price := stock.Close
+		if price < minReferencePrice {
+			continue
+		}
+
+		tickers[stock.Code] = &marketv1.TickerData{
+			Symbol: stock.Code,
+			Name:   stock.Name,
+			Price:  price,
+		}
+	}
+
+	return tickers
+}
+
+// UniverseChange reports symbols listed in or delisted from the engine.
+type UniverseChange struct {
+	// Date is the day of the snapshot that caused the change; it is zero
+	// for symbols listed by a replayed tape.
+	Date     time.Time
+	Listed   []*marketv1.TickerData
+	Delisted []string
+}
+
+// universeBuffer is how many changes a subscriber can fall behind by before
+// further changes are dropped for it.
+const universeBuffer = 16
+
+// SubscribeUniverse returns a channel that receives every change to the
+// listed symbols, and a function that ends the subscription.
+func (engine *MarketEngine) SubscribeUniverse() (<-chan UniverseChange, func()) {
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	engine.universeSequence++
+	id := engine.universeSequence
+	changes := make(chan UniverseChange, universeBuffer)
+	engine.universeSubscribers[id] = changes
+
+	return changes, func() {
+		engine.Mu.Lock()
+		defer engine.Mu.Unlock()
+
+		delete(engine.universeSubscribers, id)
+	}
+}
+
+// ReloadResult is what a reference data reload changed.
+type ReloadResult struct {
+	Date    time.Time
+	Symbols int
+	// Listed and Delisted are the symbols that were not in the engine
+	// before, and those dropped because the snapshot no longer has them.
+	Listed   []string
+	Delisted []string
+}
+
+// ReloadReferenceData starts a new simulated day from the repository's
+// snapshot for the given day, or its latest when the date is zero. The
+// snapshot replaces the listed symbols in one step, so no order or request
+// sees a mix of old and new reference data: symbols in it take its name
+// and close as their reference, last and fundamental price, new symbols
+// are listed, and symbols missing from it are delisted with their resting
+// orders cancelled. Corporate actions already applied since the snapshot
+// are applied to its prices again, but not to resting orders, which are
+// already in the new units.
+func (engine *MarketEngine) ReloadReferenceData(store repository.StockRepository, date time.Time) (ReloadResult, error) {
+	referenceDate, stocks, err := store.Load(date)
+	if err != nil {
+		return ReloadResult{}, err
+	}
+
+	tickers := referenceTickers(stocks)
+	if len(tickers) == 0 {
+		return ReloadResult{}, fmt.Errorf("the %s snapshot has no usable reference prices", referenceDate.Format("2006-01-02"))
+	}
+
+	engine.Mu.Lock()
+	defer engine.Mu.Unlock()
+
+	change := UniverseChange{Date: referenceDate}
+	for _, symbol := range slices.Sorted(maps.Keys(tickers)) {
+		if _, exists := engine.Tickers[symbol]; !exists {
+			change.Listed = append(change.Listed, tickers[symbol])
+		}
+	}
+	for _, symbol := range slices.Sorted(maps.Keys(engine.Tickers)) {
+		if _, exists := tickers[symbol]; !exists {
+			change.Delisted = append(change.Delisted, symbol)
+		}
+	}
+
+	for _, symbol := range change.Delisted {
+		engine.delist(symbol)
+	}
+
+	engine.Tickers = tickers
+	engine.referenceDate = referenceDate
+	for symbol, data := range tickers {
+		engine.CurrentPrices[symbol] = data.Price
+		delete(engine.fundamentals, symbol)
+	}
+
+	engine.reapplyCorporateActions()
+	for _, symbol := range slices.Sorted(maps.Keys(tickers)) {
+		engine.emit(models.Event{Type: models.EventPriceUpdate, Ticker: symbol, Price: engine.CurrentPrices[symbol]})
+	}
+
+	result := ReloadResult{Date: referenceDate, Symbols: len(tickers), Delisted: change.Delisted}
+	for _, data := range change.Listed {
+		result.Listed = append(result.Listed, data.Symbol)
+	}
+
+	if len(change.Listed) > 0 || len(change.Delisted) > 0 {
+		log.Printf("[Engine] Listed %d and delisted %d symbols from the %s snapshot", len(change.Listed), len(change.Delisted), referenceDate.Format("2006-01-02"))
+		engine.notifyUniverse(change)
+	}
+
+	return result, nil
+}
+
+// delist cancels a symbol's resting orders and drops its market state. The
+// caller must hold engine.Mu and remove the symbol from engine.Tickers.
+func (engine *MarketEngine) delist(symbol string) {
+	var resting []*models.Order
+	for _, order := range engine.orders {
+		if order.Ticker == symbol {
+			resting = append(resting, order)
+		}
+	}
+
+	for _, order := range resting {
+		engine.cancel(order)
+	}
+
+	delete(engine.orderBooks, symbol)
+	delete(engine.CurrentPrices, symbol)
+	delete(engine.fundamentals, symbol)
+	delete(engine.volatility, symbol)
+	delete(engine.halted, symbol)
+}
+
+// notifyUniverse hands a change to the universe subscribers without waiting
+// for them. The caller must hold engine.Mu.
+func (engine *MarketEngine) notifyUniverse(change UniverseChange) {
+	for id, changes := range engine.universeSubscribers {
+		select {
+		case changes <- change:
+		default:
+			log.Printf("[Engine] Universe subscriber %d is not keeping up, dropped a change", id)
+		}
+	}
+}
+
+// Ticker returns a copy of a listed symbol's reference data.
+func (engine *MarketEngine) Ticker(symbol string) (*marketv1.TickerData, bool) {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	data, exists := engine.Tickers[symbol]
+	if !exists {
+		return nil, false
+	}
+
+	return &marketv1.TickerData{Symbol: data.Symbol, Name: data.Name, Price: data.Price}, true
+}
+
+// TickerList returns a copy of every listed symbol's reference data with
+// its last price, sorted by symbol.
+func (engine *MarketEngine) TickerList() []*marketv1.TickerData {
+	engine.Mu.RLock()
+	defer engine.Mu.RUnlock()
+
+	tickers := make([]*marketv1.TickerData, 0, len(engine.Tickers))
+	for _, symbol := range slices.Sorted(maps.Keys(engine.Tickers)) {
+		data := engine.Tickers[symbol]
+		price := data.Price
+		if last, exists := engine.CurrentPrices[symbol]; exists {
+			price = last
+		}
+
+		tickers = append(tickers, &marketv1.TickerData{Symbol: data.Symbol, Name: data.Name, Price: price})
+	}
+
+	return tickers
+}
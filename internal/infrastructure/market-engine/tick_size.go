@@ -0,0 +1,57 @@
+package marketengine
+
+import "math"
+
+// TickSize returns the IDX price fraction (fraksi harga) for the given price.
+func TickSize(price float64) float64 {
+	switch {
+	case price < 200:
+		return 1
+	case price < 500:
+		return 2
+	case price < 2000:
+		return 5
+	case price < 5000:
+		return 10
+	default:
+		return 25
+	}
+}
+
+// IsValidTick reports whether price sits on the fraction grid of its band.
+func IsValidTick(price float64) bool {
+	if price <= 0 {
+		return false
+	}
+
+	tick := TickSize(price)
+	return math.Mod(price, tick) == 0
+}
+
+// RoundDownToTick snaps price to the nearest valid tick at or below it.
+func RoundDownToTick(price float64) float64 {
+	tick := TickSize(price)
+	return math.Floor(price/tick) * tick
+}
+
+// RoundUpToTick snaps price to the nearest valid tick at or above it.
+func RoundUpToTick(price float64) float64 {
+	tick := TickSize(price)
+	rounded := math.Ceil(price/tick) * tick
+	if TickSize(rounded) != tick {
+		return RoundUpToTick(rounded)
+	}
+
+	return rounded
+}
+
+// RoundToTick snaps price to the nearest valid tick, rounding halfway
+// prices up. Prices below one tick round to the lowest tick.
+func RoundToTick(price float64) float64 {
+	down, up := RoundDownToTick(price), RoundUpToTick(price)
+	if down > 0 && price-down < up-price {
+		return down
+	}
+
+	return max(up, 1)
+}
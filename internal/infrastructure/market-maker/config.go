@@ -0,0 +1,139 @@
+package marketmaker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config controls how one market maker quotes its symbol.
+type Config struct {
+	// SpreadTicks is the minimum distance between the best bid and best ask.
+	SpreadTicks int `json:"spread_ticks"`
+	// Levels is the number of price levels quoted on each side.
+	Levels int `json:"levels"`
+	// LevelSpacingTicks is the distance between consecutive levels.
+	LevelSpacingTicks int `json:"level_spacing_ticks"`
+	// LotsPerLevel is the quoted size of each level in board lots.
+	LotsPerLevel int `json:"lots_per_level"`
+	// MaxInventoryLots caps the long or short position the agent will carry.
+	MaxInventoryLots int `json:"max_inventory_lots"`
+	// SkewTicks shifts the quote midpoint away from the inventory at full
+	// position, so a long agent sells cheaper and a short agent bids higher.
+	// Zero turns skew off; a symbol without it takes the default's.
+	SkewTicks *float64 `json:"skew_ticks"`
+	// RefreshIntervalMs is how often the agent checks the last traded price.
+	RefreshIntervalMs int `json:"refresh_interval_ms"`
+}
+
+type Settings struct {
+	Enabled bool `json:"enabled"`
+	// AllSymbols quotes every loaded symbol with the default config. When it
+	// is false only the symbols listed in Symbols are quoted.
+	AllSymbols bool              `json:"all_symbols"`
+	Default    Config            `json:"default"`
+	Symbols    map[string]Config `json:"symbols"`
+}
+
+func DefaultSettings() Settings {
+	skewTicks := 2.0
+
+	return Settings{
+		Enabled:    true,
+		AllSymbols: true,
+		Default: Config{
+			SpreadTicks:       2,
+			Levels:            5,
+			LevelSpacingTicks: 1,
+			LotsPerLevel:      50,
+			MaxInventoryLots:  2000,
+			SkewTicks:         &skewTicks,
+			RefreshIntervalMs: 1000,
+		},
+	}
+}
+
+// LoadSettings reads a JSON settings file. Fields missing from the file keep
+// their default values.
+func LoadSettings(path string) (Settings, error) {
+	settings := DefaultSettings()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, err
+	}
+
+	// Symbol overrides fall back to the default for values that are not
+	// positive, but the default has nothing to fall back to.
+	positive := []struct {
+		name  string
+		value int
+	}{
+		{"spread_ticks", settings.Default.SpreadTicks},
+		{"levels", settings.Default.Levels},
+		{"level_spacing_ticks", settings.Default.LevelSpacingTicks},
+		{"lots_per_level", settings.Default.LotsPerLevel},
+		{"max_inventory_lots", settings.Default.MaxInventoryLots},
+		{"refresh_interval_ms", settings.Default.RefreshIntervalMs},
+	}
+	for _, field := range positive {
+		if field.value <= 0 {
+			return settings, fmt.Errorf("default: %s must be positive", field.name)
+		}
+	}
+
+	return settings, nil
+}
+
+// ConfigFor returns the symbol's override merged onto the default config.
+func (settings Settings) ConfigFor(symbol string) (Config, bool) {
+	override, exists := settings.Symbols[symbol]
+	if !exists {
+		return settings.Default, settings.AllSymbols
+	}
+
+	return override.mergedWith(settings.Default), true
+}
+
+func (config Config) mergedWith(fallback Config) Config {
+	if config.SpreadTicks <= 0 {
+		config.SpreadTicks = fallback.SpreadTicks
+	}
+	if config.Levels <= 0 {
+		config.Levels = fallback.Levels
+	}
+	if config.LevelSpacingTicks <= 0 {
+		config.LevelSpacingTicks = fallback.LevelSpacingTicks
+	}
+	if config.LotsPerLevel <= 0 {
+		config.LotsPerLevel = fallback.LotsPerLevel
+	}
+	if config.MaxInventoryLots <= 0 {
+		config.MaxInventoryLots = fallback.MaxInventoryLots
+	}
+	if config.SkewTicks == nil {
+		config.SkewTicks = fallback.SkewTicks
+	}
+	if config.RefreshIntervalMs <= 0 {
+		config.RefreshIntervalMs = fallback.RefreshIntervalMs
+	}
+
+	return config
+}
+
+// skewTicks is SkewTicks, or zero when no config sets it.
+func (config Config) skewTicks() float64 {
+	if config.SkewTicks == nil {
+		return 0
+	}
+	return *config.SkewTicks
+}
+
+func (config Config) refreshInterval() time.Duration {
+	return time.Duration(config.RefreshIntervalMs) * time.Millisecond
+}
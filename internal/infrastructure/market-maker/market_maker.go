@@ -0,0 +1,286 @@
+package marketmaker
+
+import (
+	"context"
+	"errors"
+	"log"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/models"
+	"sync"
+	"time"
+)
+
+// Agent keeps a two-sided ladder of limit orders around a symbol's last
+// traded price and requotes whenever that price moves or one of its orders
+// fills.
+type Agent struct {
+	engine *marketengine.MarketEngine
+	symbol string
+	owner  string
+	config Config
+
+	mu        sync.Mutex
+	inventory int
+	filled    chan struct{}
+
+	quotes         []string
+	lastFair       float64
+	lastInventory  int
+	lastVolatility float64
+	hasQuoted      bool
+}
+
+func NewAgent(engine *marketengine.MarketEngine, symbol string, config Config) *Agent {
+	return &Agent{
+		engine: engine,
+		symbol: symbol,
+		owner:  owner(symbol),
+		config: config,
+		filled: make(chan struct{}, 1),
+	}
+}
+
+// owner is the order owner of a symbol's market maker.
+func owner(symbol string) string {
+	return "MM-" + symbol
+}
+
+func (agent *Agent) Owner() string {
+	return agent.owner
+}
+
+// Inventory returns the agent's net position in shares.
+func (agent *Agent) Inventory() int {
+	agent.mu.Lock()
+	defer agent.mu.Unlock()
+
+	return agent.inventory
+}
+
+// onFill is called from the engine's trade listener, so it only records the
+// position change and signals the run loop to requote.
+func (agent *Agent) onFill(side string, size int) {
+	agent.mu.Lock()
+	if side == models.SideBuy {
+		agent.inventory += size
+	} else {
+		agent.inventory -= size
+	}
+	agent.mu.Unlock()
+
+	select {
+	case agent.filled <- struct{}{}:
+	default:
+	}
+}
+
+func (agent *Agent) Run(ctx context.Context) {
+	ticker := time.NewTicker(agent.config.refreshInterval())
+	defer ticker.Stop()
+
+	// Quotes recovered from the journal belong to a previous run.
+	agent.engine.CancelOwned(agent.owner)
+	agent.requote(true)
+
+	for {
+		select {
+		case <-ctx.Done():
+			agent.cancelQuotes()
+			return
+		case <-agent.filled:
+			agent.requote(true)
+		case <-ticker.C:
+			agent.requote(false)
+		}
+	}
+}
+
+func (agent *Agent) requote(force bool) {
+	fair, exists := agent.engine.LastPrice(agent.symbol)
+	if !exists {
+		return
+	}
+
+	inventory := agent.Inventory()
+	maxInventory := agent.config.MaxInventoryLots * models.LotSize
+	volatility := agent.engine.Volatility(agent.symbol)
+
+	unchanged := fair == agent.lastFair && inventory == agent.lastInventory && volatility == agent.lastVolatility
+	if !force && agent.hasQuoted && unchanged {
+		return
+	}
+
+	agent.cancelQuotes()
+
+	tick := marketengine.TickSize(fair)
+	ratio := float64(inventory) / float64(maxInventory)
+	reservation := fair - ratio*agent.config.skewTicks()*tick
+	// Widen the ladder when a scenario raises volatility.
+	halfSpread := float64(agent.config.SpreadTicks) * tick * volatility / 2
+	spacing := float64(agent.config.LevelSpacingTicks) * tick
+	levelSize := agent.config.LotsPerLevel * models.LotSize
+
+	bidCapacity := maxInventory - inventory
+	askCapacity := maxInventory + inventory
+
+	for level := range agent.config.Levels {
+		offset := halfSpread + float64(level)*spacing
+
+		bidPrice := marketengine.RoundDownToTick(reservation - offset)
+		if size := min(levelSize, bidCapacity); size > 0 && bidPrice > 0 {
+			if agent.place(models.SideBuy, bidPrice, size) {
+				bidCapacity -= size
+			}
+		}
+
+		askPrice := marketengine.RoundUpToTick(reservation + offset)
+		if size := min(levelSize, askCapacity); size > 0 {
+			if agent.place(models.SideSell, askPrice, size) {
+				askCapacity -= size
+			}
+		}
+	}
+
+	agent.lastFair = fair
+	agent.lastInventory = inventory
+	agent.lastVolatility = volatility
+	agent.hasQuoted = true
+}
+
+func (agent *Agent) place(side string, price float64, size int) bool {
+	order, _, err := agent.engine.SubmitOrder(models.Order{
+		Owner:    agent.owner,
+		Ticker:   agent.symbol,
+		Side:     side,
+		Type:     models.OrderTypeLimit,
+		Price:    price,
+		Quantity: size,
+	})
+	if err != nil {
+		log.Printf("[MarketMaker] %s quote rejected: %v", agent.symbol, err)
+		return false
+	}
+
+	if order.IsOpen() {
+		agent.quotes = append(agent.quotes, order.ID)
+	}
+
+	return true
+}
+
+func (agent *Agent) cancelQuotes() {
+	for _, orderID := range agent.quotes {
+		_, err := agent.engine.CancelOrder(orderID)
+		if err != nil && !errors.Is(err, marketengine.ErrOrderNotFound) {
+			log.Printf("[MarketMaker] %s cancel failed: %v", agent.symbol, err)
+		}
+	}
+
+	agent.quotes = agent.quotes[:0]
+}
+
+// Manager runs one agent per listed symbol, starting and stopping agents as
+// reference data reloads list and delist symbols.
+type Manager struct {
+	engine   *marketengine.MarketEngine
+	settings Settings
+
+	mu      sync.Mutex
+	agents  map[string]*Agent
+	running map[string]context.CancelFunc
+}
+
+func NewManager(engine *marketengine.MarketEngine, settings Settings) *Manager {
+	return &Manager{
+		engine:   engine,
+		settings: settings,
+		agents:   make(map[string]*Agent),
+		running:  make(map[string]context.CancelFunc),
+	}
+}
+
+func (manager *Manager) Start(ctx context.Context) {
+	if !manager.settings.Enabled {
+		log.Println("[MarketMaker] Disabled")
+		return
+	}
+
+	universe, unsubscribe := manager.engine.SubscribeUniverse()
+	manager.engine.AddTradeListener(manager.routeFill)
+
+	for _, symbol := range manager.engine.Symbols() {
+		manager.start(ctx, symbol)
+	}
+
+	manager.mu.Lock()
+	log.Printf("[MarketMaker] Quoting %d symbols", len(manager.agents))
+	manager.mu.Unlock()
+
+	go func() {
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case change := <-universe:
+				for _, symbol := range change.Delisted {
+					manager.stop(symbol)
+				}
+				for _, data := range change.Listed {
+					manager.start(ctx, data.Symbol)
+				}
+			}
+		}
+	}()
+}
+
+// start runs an agent for the symbol if its settings enable one.
+func (manager *Manager) start(ctx context.Context, symbol string) {
+	config, enabled := manager.settings.ConfigFor(symbol)
+	if !enabled {
+		return
+	}
+
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	agent := NewAgent(manager.engine, symbol, config)
+	if _, exists := manager.agents[agent.Owner()]; exists {
+		return
+	}
+
+	agentCtx, cancel := context.WithCancel(ctx)
+	manager.agents[agent.Owner()] = agent
+	manager.running[agent.Owner()] = cancel
+	go agent.Run(agentCtx)
+}
+
+// stop ends the agent for a delisted symbol. The engine has already
+// cancelled its quotes.
+func (manager *Manager) stop(symbol string) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if cancel, exists := manager.running[owner(symbol)]; exists {
+		cancel()
+		delete(manager.running, owner(symbol))
+		delete(manager.agents, owner(symbol))
+		log.Printf("[MarketMaker] Stopped quoting delisted %s", symbol)
+	}
+}
+
+func (manager *Manager) routeFill(trade models.Trade) {
+	manager.mu.Lock()
+	buyer := manager.agents[trade.Buyer]
+	seller := manager.agents[trade.Seller]
+	manager.mu.Unlock()
+
+	if buyer != nil {
+		buyer.onFill(models.SideBuy, trade.Size)
+	}
+
+	if seller != nil {
+		seller.onFill(models.SideSell, trade.Size)
+	}
+}
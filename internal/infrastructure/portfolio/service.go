@@ -0,0 +1,296 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"market-engine-go/internal/infrastructure/accounts"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"math"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Unclassified is the sector of stocks in no IDX-IC sector index.
+const Unclassified = "Unclassified"
+
+// Service values accounts at the engine's last prices and streams their
+// valuations as prices move and accounts change, so every client sees the
+// same numbers.
+type Service struct {
+	engine *marketengine.MarketEngine
+	ledger *accounts.Ledger
+	// store is where sector index constituents are read from.
+	store repository.DatasetRepository
+
+	mu sync.RWMutex
+	// sectors maps symbols to sectors.
+	sectors         map[string]string
+	watcherSequence uint64
+	watchers        map[uint64]*watcher
+}
+
+// watcher is a stream waiting for price moves in the symbols it holds.
+type watcher struct {
+	symbols map[string]bool
+	wake    chan struct{}
+}
+
+func NewService(engine *marketengine.MarketEngine, ledger *accounts.Ledger, store repository.DatasetRepository) *Service {
+	return &Service{
+		engine:   engine,
+		ledger:   ledger,
+		store:    store,
+		sectors:  make(map[string]string),
+		watchers: make(map[uint64]*watcher),
+	}
+}
+
+// Start classifies symbols by sector and follows engine price updates. The
+// classification is refreshed whenever the listed symbols change, until
+// ctx ends.
+func (service *Service) Start(ctx context.Context) {
+	service.loadSectors()
+	service.engine.AddEventListener(service.onEvent)
+
+	changes, unsubscribe := service.engine.SubscribeUniverse()
+	go func() {
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changes:
+				service.loadSectors()
+			}
+		}
+	}()
+}
+
+// loadSectors classifies symbols by the latest saved constituents of the
+// IDX-IC sector indices.
+func (service *Service) loadSectors() {
+	sectors := make(map[string]string)
+	for index, sector := range models.SectorIndices {
+		constituents, err := service.store.IndexConstituents(index, time.Time{})
+		if err != nil {
+			log.Printf("[Portfolio] Failed to read %s constituents: %v", index, err)
+			continue
+		}
+
+		for _, constituent := range constituents {
+			sectors[constituent.Code] = sector
+		}
+	}
+
+	service.mu.Lock()
+	service.sectors = sectors
+	service.mu.Unlock()
+
+	log.Printf("[Portfolio] Classified %d symbols by sector", len(sectors))
+}
+
+func (service *Service) Sector(symbol string) string {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+
+	if sector, exists := service.sectors[symbol]; exists {
+		return sector
+	}
+
+	return Unclassified
+}
+
+// onEvent wakes the streams holding a symbol whose price moved. It runs with
+// the engine lock held.
+func (service *Service) onEvent(event models.Event) {
+	if event.Type != models.EventPriceUpdate {
+		return
+	}
+
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+
+	for _, watcher := range service.watchers {
+		if !watcher.symbols[event.Ticker] {
+			continue
+		}
+
+		select {
+		case watcher.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Value values an account at last prices. A symbol that has never traded is
+// valued at its reference price, and one no longer listed at its average
+// cost.
+func (service *Service) Value(account models.Account) models.Portfolio {
+	portfolio := models.Portfolio{
+		AccountID: account.ID,
+		Timestamp: service.engine.Now(),
+		Cash:      account.Cash,
+		Positions: make([]models.PositionValue, 0, len(account.Positions)),
+	}
+
+	exposure := make(map[string]*models.SectorExposure)
+	for _, position := range account.Positions {
+		lastPrice, exists := service.engine.LastPrice(position.Symbol)
+		if !exists {
+			lastPrice = position.AverageCost
+		}
+
+		value := models.PositionValue{
+			Position:        position,
+			SettledQuantity: account.SettledQuantity(position.Symbol),
+			Sector:          service.Sector(position.Symbol),
+			LastPrice:       lastPrice,
+			MarketValue:     lastPrice * float64(position.Quantity),
+			UnrealizedPnL:   roundSen((lastPrice - position.AverageCost) * float64(position.Quantity)),
+		}
+		portfolio.Positions = append(portfolio.Positions, value)
+
+		portfolio.MarketValue += value.MarketValue
+		portfolio.RealizedPnL += position.RealizedPnL
+		portfolio.UnrealizedPnL += value.UnrealizedPnL
+
+		if position.Quantity == 0 {
+			continue
+		}
+
+		sector, exists := exposure[value.Sector]
+		if !exists {
+			sector = &models.SectorExposure{Sector: value.Sector}
+			exposure[value.Sector] = sector
+		}
+		sector.MarketValue += value.MarketValue
+		sector.UnrealizedPnL += value.UnrealizedPnL
+	}
+	portfolio.RealizedPnL = roundSen(portfolio.RealizedPnL)
+	portfolio.UnrealizedPnL = roundSen(portfolio.UnrealizedPnL)
+	portfolio.Equity = portfolio.Cash + portfolio.MarketValue
+
+	for _, sector := range exposure {
+		sector.UnrealizedPnL = roundSen(sector.UnrealizedPnL)
+		if portfolio.Equity > 0 {
+			sector.Weight = sector.MarketValue / portfolio.Equity
+		}
+		portfolio.Sectors = append(portfolio.Sectors, *sector)
+	}
+	slices.SortFunc(portfolio.Sectors, func(a models.SectorExposure, b models.SectorExposure) int {
+		return cmp.Or(cmp.Compare(b.MarketValue, a.MarketValue), cmp.Compare(a.Sector, b.Sector))
+	})
+
+	return portfolio
+}
+
+// Watch sends the account's valuation, then again whenever a fill, order,
+// funding or price move in a held symbol changes it, at most once per
+// interval, until ctx ends or send fails.
+func (service *Service) Watch(ctx context.Context, accountID string, interval time.Duration, send func(models.Portfolio) error) error {
+	updates, unsubscribe, err := service.ledger.Subscribe(accountID)
+	if err != nil {
+		return err
+	}
+	defer unsubscribe()
+
+	account, err := service.ledger.Account(accountID)
+	if err != nil {
+		return err
+	}
+
+	key, watcher := service.addWatcher()
+	defer service.removeWatcher(key)
+
+	last := service.Value(account)
+	if err := send(last); err != nil {
+		return err
+	}
+	service.follow(key, account)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	changed := false
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update := <-updates:
+			account = update.Account
+			service.follow(key, account)
+			changed = true
+		case <-watcher.wake:
+			changed = true
+		case <-ticker.C:
+			if !changed {
+				continue
+			}
+			changed = false
+
+			portfolio := service.Value(account)
+			if sameValuation(portfolio, last) {
+				continue
+			}
+
+			if err := send(portfolio); err != nil {
+				return err
+			}
+			last = portfolio
+		}
+	}
+}
+
+func (service *Service) addWatcher() (uint64, *watcher) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.watcherSequence++
+	watcher := &watcher{symbols: make(map[string]bool), wake: make(chan struct{}, 1)}
+	service.watchers[service.watcherSequence] = watcher
+
+	return service.watcherSequence, watcher
+}
+
+func (service *Service) removeWatcher(key uint64) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	delete(service.watchers, key)
+}
+
+// follow points a watcher at the symbols the account holds.
+func (service *Service) follow(key uint64, account models.Account) {
+	symbols := make(map[string]bool, len(account.Positions))
+	for _, position := range account.Positions {
+		if position.Quantity != 0 {
+			symbols[position.Symbol] = true
+		}
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if watcher, exists := service.watchers[key]; exists {
+		watcher.symbols = symbols
+	}
+}
+
+// roundSen rounds an amount to the sen, since average costs carry fees that
+// do not divide evenly.
+func roundSen(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// sameValuation reports whether two valuations differ only in when they
+// were taken.
+func sameValuation(a models.Portfolio, b models.Portfolio) bool {
+	a.Timestamp, b.Timestamp = time.Time{}, time.Time{}
+	return reflect.DeepEqual(a, b)
+}
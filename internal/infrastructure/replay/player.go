@@ -0,0 +1,201 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"log"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status describes where a player is on its tape.
+type Status struct {
+	Position int
+	Length   int
+	Playing  bool
+	Speed    float64
+	Time     time.Time
+}
+
+// Player re-emits a tape through the engine's trade and price feeds. The
+// engine runs on a manual clock that follows the tape timestamps, so
+// streamed timestamps match the recording at any playback speed.
+type Player struct {
+	engine *marketengine.MarketEngine
+	tape   []Entry
+	clock  *marketengine.ManualClock
+
+	mu       sync.Mutex
+	position int
+	playing  bool
+	speed    float64
+	// generation changes on every control call so a pending wait knows its
+	// schedule is stale.
+	generation uint64
+	wake       chan struct{}
+}
+
+// NewPlayer puts the engine on the tape's clock. A positive speed plays at
+// that multiple of recorded time once Run is called; zero starts paused for
+// stepping.
+func NewPlayer(engine *marketengine.MarketEngine, tape []Entry, speed float64) *Player {
+	player := &Player{
+		engine:  engine,
+		tape:    tape,
+		clock:   marketengine.NewManualClock(tape[0].Time),
+		playing: speed > 0,
+		speed:   speed,
+		wake:    make(chan struct{}, 1),
+	}
+
+	if player.speed <= 0 {
+		player.speed = 1
+	}
+
+	engine.SetClock(player.clock)
+	engine.ResetMarketData(nil)
+
+	return player
+}
+
+// Run plays the tape until ctx is cancelled. Reaching the end pauses the
+// player, so a seek can start it again.
+func (player *Player) Run(ctx context.Context) {
+	log.Printf("[Replay] Loaded %d entries from %s to %s", len(player.tape),
+		player.tape[0].Time.Format(time.RFC3339), player.tape[len(player.tape)-1].Time.Format(time.RFC3339))
+
+	for {
+		player.mu.Lock()
+		if player.position >= len(player.tape) && player.playing {
+			player.playing = false
+			log.Printf("[Replay] Reached end of tape")
+		}
+
+		var timer <-chan time.Time
+		generation := player.generation
+		if player.playing {
+			next := player.tape[player.position]
+			delay := time.Duration(float64(next.Time.Sub(player.clock.Now())) / player.speed)
+			timer = time.After(max(delay, 0))
+		}
+		player.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-player.wake:
+		case <-timer:
+			player.mu.Lock()
+			if player.generation == generation {
+				player.advance()
+			}
+			player.mu.Unlock()
+		}
+	}
+}
+
+// advance publishes the entry at the current position. The caller must hold
+// player.mu.
+func (player *Player) advance() {
+	entry := player.tape[player.position]
+	player.position++
+	player.clock.Set(entry.Time)
+
+	if entry.Trade != nil {
+		player.engine.PublishTrade(*entry.Trade)
+		return
+	}
+
+	player.engine.PublishPrice(entry.Ticker, entry.Price)
+}
+
+// control applies a change and reschedules the playback loop.
+func (player *Player) control(change func()) Status {
+	player.mu.Lock()
+	change()
+	player.generation++
+	status := player.status()
+	player.mu.Unlock()
+
+	select {
+	case player.wake <- struct{}{}:
+	default:
+	}
+
+	return status
+}
+
+func (player *Player) Play() Status {
+	return player.control(func() {
+		player.playing = player.position < len(player.tape)
+	})
+}
+
+func (player *Player) Pause() Status {
+	return player.control(func() {
+		player.playing = false
+	})
+}
+
+// SetSpeed changes the playback multiple without pausing.
+func (player *Player) SetSpeed(speed float64) (Status, error) {
+	if speed <= 0 {
+		return player.Status(), errors.New("speed must be positive")
+	}
+
+	return player.control(func() {
+		player.speed = speed
+	}), nil
+}
+
+// Step pauses playback and publishes the next count entries immediately.
+func (player *Player) Step(count int) Status {
+	return player.control(func() {
+		player.playing = false
+		for range count {
+			if player.position >= len(player.tape) {
+				return
+			}
+			player.advance()
+		}
+	})
+}
+
+// Seek moves to the first entry at or after the given time. Last prices are
+// rebuilt from the tape before that point and the trade feed is cleared; the
+// play state is kept.
+func (player *Player) Seek(at time.Time) Status {
+	return player.control(func() {
+		player.position = sort.Search(len(player.tape), func(i int) bool {
+			return !player.tape[i].Time.Before(at)
+		})
+
+		lastPrices := make(map[string]float64)
+		for _, entry := range player.tape[:player.position] {
+			lastPrices[entry.Ticker] = entry.Price
+		}
+
+		player.engine.ResetMarketData(lastPrices)
+		player.clock.Set(at)
+		player.playing = player.playing && player.position < len(player.tape)
+	})
+}
+
+func (player *Player) Status() Status {
+	player.mu.Lock()
+	defer player.mu.Unlock()
+
+	return player.status()
+}
+
+func (player *Player) status() Status {
+	return Status{
+		Position: player.position,
+		Length:   len(player.tape),
+		Playing:  player.playing,
+		Speed:    player.speed,
+		Time:     player.clock.Now(),
+	}
+}
@@ -0,0 +1,166 @@
+package replay
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"market-engine-go/internal/infrastructure/journal"
+	"market-engine-go/internal/models"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entry is one step of a tape: either a trade or a price (quote) update.
+type Entry struct {
+	Time   time.Time
+	Trade  *models.Trade
+	Ticker string
+	Price  float64
+}
+
+// Load reads a tape from a journal directory or a CSV file.
+func Load(path string) ([]Entry, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return LoadJournal(path)
+	}
+
+	return LoadCSV(path)
+}
+
+// LoadJournal turns the trades and price updates of a recorded journal into
+// a tape.
+func LoadJournal(dir string) ([]Entry, error) {
+	events, err := journal.ReadEvents(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var tape []Entry
+	for _, event := range events {
+		switch event.Type {
+		case models.EventTrade:
+			tape = append(tape, Entry{Time: event.Timestamp, Trade: event.Trade, Ticker: event.Trade.Ticker, Price: event.Trade.Price})
+		case models.EventPriceUpdate:
+			tape = append(tape, Entry{Time: event.Timestamp, Ticker: event.Ticker, Price: event.Price})
+		}
+	}
+
+	if len(tape) == 0 {
+		return nil, errors.New("journal has no trades or price updates")
+	}
+
+	return tape, nil
+}
+
+// LoadCSV reads a tape with a header row. Required columns are timestamp
+// (RFC 3339 or Unix milliseconds) and symbol. Rows with a positive size are
+// trades at price; other rows are quotes at price or at the bid/ask midpoint.
+func LoadCSV(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{"timestamp", "symbol"} {
+		if _, exists := columns[required]; !exists {
+			return nil, fmt.Errorf("missing %q column", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		index, exists := columns[name]
+		if !exists || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	number := func(record []string, name string) float64 {
+		value, _ := strconv.ParseFloat(field(record, name), 64)
+		return value
+	}
+
+	var tape []Entry
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		timestamp, err := parseTimestamp(field(record, "timestamp"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		entry := Entry{Time: timestamp, Ticker: field(record, "symbol"), Price: number(record, "price")}
+		if entry.Price == 0 {
+			if bid, ask := number(record, "bid"), number(record, "ask"); bid > 0 && ask > 0 {
+				entry.Price = (bid + ask) / 2
+			}
+		}
+
+		if entry.Ticker == "" || entry.Price <= 0 {
+			return nil, fmt.Errorf("line %d: missing symbol or price", line)
+		}
+
+		if size := int(number(record, "size")); size > 0 {
+			entry.Trade = &models.Trade{
+				ID:        field(record, "id"),
+				Ticker:    entry.Ticker,
+				Price:     entry.Price,
+				Size:      size,
+				Side:      strings.ToUpper(field(record, "side")),
+				Timestamp: timestamp,
+			}
+			if entry.Trade.ID == "" {
+				entry.Trade.ID = fmt.Sprintf("TAPE-%d", line)
+			}
+		}
+
+		tape = append(tape, entry)
+	}
+
+	if len(tape) == 0 {
+		return nil, errors.New("tape is empty")
+	}
+
+	slices.SortStableFunc(tape, func(a, b Entry) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	return tape, nil
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(millis), nil
+	}
+
+	return time.Parse(time.RFC3339Nano, value)
+}
@@ -0,0 +1,418 @@
+package repository
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"market-engine-go/internal/models"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The CSV store keeps each dataset beside the daily snapshots: one file per
+// day under foreign_flows/ and broker_summaries/, one per index and day
+// under index_constituents/<INDEX>/, and every corporate action in
+// corporate_actions.csv. Numbers are written plainly, not in the IDX locale.
+const (
+	foreignFlowsDir      = "foreign_flows"
+	brokerSummariesDir   = "broker_summaries"
+	indexConstituentsDir = "index_constituents"
+	corporateActionsFile = "corporate_actions.csv"
+)
+
+var (
+	foreignFlowColumns      = []string{"code", "buy_volume", "sell_volume"}
+	brokerSummaryColumns    = []string{"broker_code", "broker_name", "volume", "value", "frequency"}
+	indexConstituentColumns = []string{"code", "name", "weight"}
+	corporateActionColumns  = []string{"code", "type", "ex_date", "record_date", "payment_date", "amount", "ratio", "price", "description"}
+)
+
+func (store *SnapshotStore) SaveForeignFlows(date time.Time, flows []models.ForeignFlow) error {
+	rows := make([][]string, 0, len(flows))
+	for _, flow := range flows {
+		rows = append(rows, []string{flow.Code, formatInt(flow.BuyVolume), formatInt(flow.SellVolume)})
+	}
+
+	return writeCsvFile(store.datedPath(foreignFlowsDir, date), foreignFlowColumns, rows)
+}
+
+func (store *SnapshotStore) ForeignFlows(symbol string, from time.Time, to time.Time) ([]models.ForeignFlow, error) {
+	files, err := datedFiles(filepath.Join(store.repository.Dir, foreignFlowsDir))
+	if err != nil {
+		return nil, err
+	}
+
+	var flows []models.ForeignFlow
+	for _, date := range slices.SortedFunc(maps.Keys(files), time.Time.Compare) {
+		if !inRange(date, from, to) {
+			continue
+		}
+
+		err := readCsvFile(files[date], func(row csvRow) error {
+			flow := models.ForeignFlow{Date: date, Code: row.text("code")}
+			if symbol != "" && flow.Code != symbol {
+				return nil
+			}
+
+			flow.BuyVolume = row.int("buy_volume")
+			flow.SellVolume = row.int("sell_volume")
+			flows = append(flows, flow)
+			return row.err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return flows, nil
+}
+
+func (store *SnapshotStore) SaveBrokerSummaries(date time.Time, summaries []models.BrokerSummary) error {
+	rows := make([][]string, 0, len(summaries))
+	for _, summary := range summaries {
+		rows = append(rows, []string{summary.BrokerCode, summary.BrokerName, formatInt(summary.Volume), formatInt(summary.Value), formatInt(summary.Frequency)})
+	}
+
+	return writeCsvFile(store.datedPath(brokerSummariesDir, date), brokerSummaryColumns, rows)
+}
+
+func (store *SnapshotStore) BrokerSummaries(date time.Time) ([]models.BrokerSummary, error) {
+	date, path, err := latestFile(filepath.Join(store.repository.Dir, brokerSummariesDir), date, true)
+	if path == "" || err != nil {
+		return nil, err
+	}
+
+	var summaries []models.BrokerSummary
+	err = readCsvFile(path, func(row csvRow) error {
+		summaries = append(summaries, models.BrokerSummary{
+			Date:       date,
+			BrokerCode: row.text("broker_code"),
+			BrokerName: row.text("broker_name"),
+			Volume:     row.int("volume"),
+			Value:      row.int("value"),
+			Frequency:  row.int("frequency"),
+		})
+		return row.err
+	})
+
+	return summaries, err
+}
+
+func (store *SnapshotStore) SaveIndexConstituents(date time.Time, index string, constituents []models.IndexConstituent) error {
+	rows := make([][]string, 0, len(constituents))
+	for _, constituent := range constituents {
+		rows = append(rows, []string{constituent.Code, constituent.Name, formatFloat(constituent.Weight)})
+	}
+
+	return writeCsvFile(store.datedPath(filepath.Join(indexConstituentsDir, index), date), indexConstituentColumns, rows)
+}
+
+func (store *SnapshotStore) IndexConstituents(index string, date time.Time) ([]models.IndexConstituent, error) {
+	date, path, err := latestFile(filepath.Join(store.repository.Dir, indexConstituentsDir, index), date, false)
+	if path == "" || err != nil {
+		return nil, err
+	}
+
+	var constituents []models.IndexConstituent
+	err = readCsvFile(path, func(row csvRow) error {
+		constituents = append(constituents, models.IndexConstituent{
+			Date:   date,
+			Index:  index,
+			Code:   row.text("code"),
+			Name:   row.text("name"),
+			Weight: row.float("weight"),
+		})
+		return row.err
+	})
+
+	return constituents, err
+}
+
+func (store *SnapshotStore) SaveCorporateActions(actions []models.CorporateAction) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	existing, err := store.readCorporateActions()
+	if err != nil {
+		return err
+	}
+
+	merged := make(map[string]models.CorporateAction, len(existing)+len(actions))
+	for _, action := range slices.Concat(existing, actions) {
+		merged[corporateActionKey(action)] = action
+	}
+
+	sorted := slices.SortedFunc(maps.Values(merged), compareCorporateActions)
+
+	rows := make([][]string, 0, len(sorted))
+	for _, action := range sorted {
+		rows = append(rows, []string{
+			action.Code,
+			action.Type,
+			formatDate(action.ExDate),
+			formatDate(action.RecordDate),
+			formatDate(action.PaymentDate),
+			formatFloat(action.Amount),
+			formatFloat(action.Ratio),
+			formatFloat(action.Price),
+			action.Description,
+		})
+	}
+
+	return writeCsvFile(filepath.Join(store.repository.Dir, corporateActionsFile), corporateActionColumns, rows)
+}
+
+func (store *SnapshotStore) CorporateActions(symbol string, from time.Time, to time.Time) ([]models.CorporateAction, error) {
+	store.mu.Lock()
+	actions, err := store.readCorporateActions()
+	store.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []models.CorporateAction
+	for _, action := range actions {
+		if (symbol == "" || action.Code == symbol) && inRange(action.ExDate, from, to) {
+			matching = append(matching, action)
+		}
+	}
+
+	return matching, nil
+}
+
+// readCorporateActions reads every saved action, sorted. The caller must
+// hold store.mu.
+func (store *SnapshotStore) readCorporateActions() ([]models.CorporateAction, error) {
+	var actions []models.CorporateAction
+	err := readCsvFile(filepath.Join(store.repository.Dir, corporateActionsFile), func(row csvRow) error {
+		actions = append(actions, models.CorporateAction{
+			Code:        row.text("code"),
+			Type:        row.text("type"),
+			ExDate:      row.date("ex_date"),
+			RecordDate:  row.date("record_date"),
+			PaymentDate: row.date("payment_date"),
+			Amount:      row.float("amount"),
+			Ratio:       row.float("ratio"),
+			Price:       row.float("price"),
+			Description: row.text("description"),
+		})
+		return row.err
+	})
+
+	return actions, err
+}
+
+func corporateActionKey(action models.CorporateAction) string {
+	return action.Code + "|" + action.Type + "|" + formatDate(action.ExDate)
+}
+
+func compareCorporateActions(a models.CorporateAction, b models.CorporateAction) int {
+	return cmp.Or(a.ExDate.Compare(b.ExDate), strings.Compare(a.Code, b.Code), strings.Compare(a.Type, b.Type))
+}
+
+func (store *SnapshotStore) datedPath(dir string, date time.Time) string {
+	return filepath.Join(store.repository.Dir, dir, startOfDay(date).Format(snapshotDateLayout)+".csv")
+}
+
+// datedFiles indexes the YYYY-MM-DD.csv files in a dataset directory, which
+// need not exist yet.
+func datedFiles(dir string) (map[time.Time]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[time.Time]string)
+	for _, entry := range entries {
+		day, found := strings.CutSuffix(entry.Name(), ".csv")
+		if entry.IsDir() || !found {
+			continue
+		}
+
+		date, err := ParseSnapshotDate(day)
+		if err != nil {
+			continue
+		}
+
+		files[date] = filepath.Join(dir, entry.Name())
+	}
+
+	return files, nil
+}
+
+// latestFile returns the dated file for the day, or with exact unset the
+// latest one on or before it. A zero date picks the latest file. The path
+// is empty when there is none.
+func latestFile(dir string, date time.Time, exact bool) (time.Time, string, error) {
+	files, err := datedFiles(dir)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	if !date.IsZero() && exact {
+		date = startOfDay(date)
+		return date, files[date], nil
+	}
+
+	var latest time.Time
+	for candidate := range files {
+		if (date.IsZero() || !candidate.After(date)) && candidate.After(latest) {
+			latest = candidate
+		}
+	}
+
+	return latest, files[latest], nil
+}
+
+func inRange(date time.Time, from time.Time, to time.Time) bool {
+	if !from.IsZero() && date.Before(startOfDay(from)) {
+		return false
+	}
+
+	return to.IsZero() || !date.After(startOfDay(to))
+}
+
+// writeCsvFile writes a dataset file under a temporary name and renames it
+// into place.
+func writeCsvFile(path string, header []string, rows [][]string) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	partialPath := path + ".partial"
+	file, err := os.Create(partialPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(partialPath)
+
+	writer := csv.NewWriter(file)
+	writer.Write(header)
+	writer.WriteAll(rows)
+
+	if err := writer.Error(); err != nil {
+		file.Close()
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	return os.Rename(partialPath, path)
+}
+
+// csvRow reads fields of a dataset row by column name. The first field that
+// does not parse is kept in err.
+type csvRow struct {
+	record  []string
+	columns map[string]int
+	err     error
+}
+
+func (row *csvRow) text(name string) string {
+	index, exists := row.columns[name]
+	if !exists || index >= len(row.record) {
+		return ""
+	}
+	return row.record[index]
+}
+
+func (row *csvRow) int(name string) int64 {
+	value := row.text(name)
+	if value == "" {
+		return 0
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil && row.err == nil {
+		row.err = fmt.Errorf("%s: %w", name, err)
+	}
+	return parsed
+}
+
+func (row *csvRow) float(name string) float64 {
+	value := row.text(name)
+	if value == "" {
+		return 0
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil && row.err == nil {
+		row.err = fmt.Errorf("%s: %w", name, err)
+	}
+	return parsed
+}
+
+func (row *csvRow) date(name string) time.Time {
+	value := row.text(name)
+	if value == "" {
+		return time.Time{}
+	}
+
+	parsed, err := ParseSnapshotDate(value)
+	if err != nil && row.err == nil {
+		row.err = fmt.Errorf("%s: %w", name, err)
+	}
+	return parsed
+}
+
+// readCsvFile calls read for every row of a dataset file, which need not
+// exist yet.
+func readCsvFile(path string, read func(row csvRow) error) error {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[name] = i
+	}
+
+	for i, record := range records[1:] {
+		if err := read(csvRow{record: record, columns: columns}); err != nil {
+			return fmt.Errorf("%s line %d: %w", path, i+2, err)
+		}
+	}
+
+	return nil
+}
+
+func formatInt(value int64) string {
+	return strconv.FormatInt(value, 10)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func formatDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return startOfDay(date).Format(snapshotDateLayout)
+}
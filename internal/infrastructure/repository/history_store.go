@@ -0,0 +1,376 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"market-engine-go/internal/models"
+	"strings"
+	"time"
+)
+
+// historyMigrations are the history store's migrations; like migrations,
+// append new ones and never edit applied ones.
+var historyMigrations = []string{
+	`CREATE TABLE execution_reports (
+		account   TEXT    NOT NULL,
+		sequence  INTEGER NOT NULL,
+		order_id  TEXT    NOT NULL,
+		type      TEXT    NOT NULL,
+		timestamp TEXT    NOT NULL,
+		report    TEXT    NOT NULL,
+		PRIMARY KEY (account, sequence)
+	);
+	CREATE INDEX execution_reports_order ON execution_reports (order_id, sequence);`,
+
+	// Orders and fills by account, kept from the reports as they are saved
+	// and filled in here from the ones saved before.
+	`CREATE TABLE orders (
+		account         TEXT    NOT NULL,
+		opened_sequence INTEGER NOT NULL,
+		last_sequence   INTEGER NOT NULL,
+		order_id        TEXT    NOT NULL,
+		symbol          TEXT    NOT NULL,
+		side            TEXT    NOT NULL,
+		status          TEXT    NOT NULL,
+		created_at      TEXT    NOT NULL,
+		report          TEXT    NOT NULL,
+		PRIMARY KEY (account, opened_sequence)
+	);
+	CREATE INDEX orders_order_id ON orders (account, order_id);
+	CREATE INDEX orders_created_at ON orders (account, created_at);
+	CREATE TABLE account_trades (
+		account    TEXT    NOT NULL,
+		sequence   INTEGER NOT NULL,
+		trade_id   TEXT    NOT NULL,
+		order_id   TEXT    NOT NULL,
+		symbol     TEXT    NOT NULL,
+		side       TEXT    NOT NULL,
+		price      REAL    NOT NULL,
+		quantity   INTEGER NOT NULL,
+		commission REAL    NOT NULL,
+		levy       REAL    NOT NULL,
+		clearing   REAL    NOT NULL,
+		vat        REAL    NOT NULL,
+		sales_tax  REAL    NOT NULL,
+		timestamp  TEXT    NOT NULL,
+		PRIMARY KEY (account, sequence)
+	);
+	CREATE INDEX account_trades_timestamp ON account_trades (account, timestamp);
+	INSERT INTO orders
+		SELECT account, sequence, sequence, order_id,
+			json_extract(report, '$.order.ticker'), json_extract(report, '$.order.side'), json_extract(report, '$.order.status'),
+			strftime('%Y-%m-%dT%H:%M:%fZ', json_extract(report, '$.order.created_at')), report
+		FROM execution_reports WHERE type IN ('NEW', 'REJECTED');
+	UPDATE orders SET last_sequence = latest.sequence, status = json_extract(latest.report, '$.order.status'), report = latest.report
+		FROM (SELECT account, order_id, MAX(sequence) AS sequence, report FROM execution_reports
+			WHERE order_id != '' GROUP BY account, order_id) AS latest
+		WHERE orders.account = latest.account AND orders.order_id = latest.order_id;
+	INSERT INTO account_trades
+		SELECT account, sequence, json_extract(report, '$.trade_id'), order_id,
+			json_extract(report, '$.order.ticker'), json_extract(report, '$.order.side'),
+			json_extract(report, '$.last_price'), json_extract(report, '$.last_quantity'),
+			COALESCE(json_extract(report, '$.fees.commission'), 0), COALESCE(json_extract(report, '$.fees.levy'), 0),
+			COALESCE(json_extract(report, '$.fees.clearing'), 0), COALESCE(json_extract(report, '$.fees.vat'), 0),
+			COALESCE(json_extract(report, '$.fees.sales_tax'), 0),
+			strftime('%Y-%m-%dT%H:%M:%fZ', json_extract(report, '$.timestamp'))
+		FROM execution_reports WHERE type IN ('PARTIAL_FILL', 'FILL');`,
+}
+
+// openExecutionTypes are the report types after which an order is still
+// working.
+var openExecutionTypes = []any{models.ExecutionNew, models.ExecutionPartialFill, models.ExecutionReplaced}
+
+// HistoryStore keeps what happened to accounts' orders in an embedded
+// SQLite database, apart from the market data in the stock repository.
+type HistoryStore struct {
+	db *sql.DB
+}
+
+func OpenHistoryStore(path string) (*HistoryStore, error) {
+	db, err := openSqlite(path, historyMigrations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoryStore{db: db}, nil
+}
+
+func (store *HistoryStore) Close() error {
+	return store.db.Close()
+}
+
+// HistoryQuery selects an account's orders or trades. Empty fields match
+// everything.
+type HistoryQuery struct {
+	Account string
+	Symbol  string
+	Side    string
+	// Status only applies to orders.
+	Status string
+	// From and To bound when orders were placed or trades made; To is
+	// exclusive.
+	From time.Time
+	To   time.Time
+	// Before continues a listing from the cursor a previous page returned.
+	Before uint64
+	Limit  int
+}
+
+// where is the SQL condition for the query, on a table whose order
+// placement or trade time is in the named column and whose cursor is in
+// sequenceColumn.
+func (query HistoryQuery) where(timeColumn string, sequenceColumn string) (string, []any) {
+	conditions := []string{"account = ?"}
+	args := []any{query.Account}
+
+	add := func(condition string, arg any) {
+		conditions = append(conditions, condition)
+		args = append(args, arg)
+	}
+	if query.Symbol != "" {
+		add("symbol = ?", query.Symbol)
+	}
+	if query.Side != "" {
+		add("side = ?", query.Side)
+	}
+	if query.Status != "" {
+		add("status = ?", query.Status)
+	}
+	if !query.From.IsZero() {
+		add(timeColumn+" >= ?", query.From.UTC().Format(sqliteTimeFormat))
+	}
+	if !query.To.IsZero() {
+		add(timeColumn+" < ?", query.To.UTC().Format(sqliteTimeFormat))
+	}
+	if query.Before > 0 {
+		add(sequenceColumn+" < ?", query.Before)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// SaveExecutionReports stores reports, in the order they were made, and
+// the orders and fills they report; ones already stored are left as they
+// are.
+func (store *HistoryStore) SaveExecutionReports(reports []models.ExecutionReport) error {
+	tx, err := store.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insert, err := tx.Prepare(`INSERT OR IGNORE INTO execution_reports
+		(account, sequence, order_id, type, timestamp, report) VALUES (?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	open, err := tx.Prepare(`INSERT OR IGNORE INTO orders
+		(account, opened_sequence, last_sequence, order_id, symbol, side, status, created_at, report)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer open.Close()
+
+	update, err := tx.Prepare(`UPDATE orders SET last_sequence = ?, status = ?, report = ?
+		WHERE account = ? AND order_id = ? AND last_sequence < ?`)
+	if err != nil {
+		return err
+	}
+	defer update.Close()
+
+	fill, err := tx.Prepare(`INSERT OR IGNORE INTO account_trades
+		(account, sequence, trade_id, order_id, symbol, side, price, quantity, commission, levy, clearing, vat, sales_tax, timestamp)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer fill.Close()
+
+	for _, report := range reports {
+		data, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+
+		order := report.Order
+		switch report.Type {
+		case models.ExecutionNew, models.ExecutionRejected:
+			_, err = open.Exec(report.Account, report.Sequence, report.Sequence, order.ID, order.Ticker, order.Side, order.Status,
+				order.CreatedAt.UTC().Format(sqliteTimeFormat), string(data))
+		default:
+			_, err = update.Exec(report.Sequence, order.Status, string(data), report.Account, order.ID, report.Sequence)
+		}
+		if err != nil {
+			return fmt.Errorf("%s #%d: %w", report.Account, report.Sequence, err)
+		}
+
+		if report.Type == models.ExecutionPartialFill || report.Type == models.ExecutionFill {
+			fees := models.Fees{}
+			if report.Fees != nil {
+				fees = *report.Fees
+			}
+
+			if _, err := fill.Exec(report.Account, report.Sequence, report.TradeID, order.ID, order.Ticker, order.Side,
+				report.LastPrice, report.LastQuantity, fees.Commission, fees.Levy, fees.Clearing, fees.VAT, fees.SalesTax,
+				report.Timestamp.UTC().Format(sqliteTimeFormat)); err != nil {
+				return fmt.Errorf("%s #%d: %w", report.Account, report.Sequence, err)
+			}
+		}
+
+		if _, err := insert.Exec(report.Account, report.Sequence, order.ID, report.Type,
+			report.Timestamp.UTC().Format(sqliteTimeFormat), string(data)); err != nil {
+			return fmt.Errorf("%s #%d: %w", report.Account, report.Sequence, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Orders returns an account's orders as their last reports left them,
+// newest first, and the cursor of the next page, zero after the last.
+func (store *HistoryStore) Orders(query HistoryQuery) ([]models.ExecutionReport, uint64, error) {
+	where, args := query.where("created_at", "opened_sequence")
+	rows, err := store.db.Query(`SELECT opened_sequence, report FROM orders WHERE `+where+`
+		ORDER BY opened_sequence DESC LIMIT ?`, append(args, query.Limit+1)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var orders []models.ExecutionReport
+	var cursors []uint64
+	for rows.Next() {
+		var opened uint64
+		var data string
+		if err := rows.Scan(&opened, &data); err != nil {
+			return nil, 0, err
+		}
+
+		var report models.ExecutionReport
+		if err := json.Unmarshal([]byte(data), &report); err != nil {
+			return nil, 0, err
+		}
+
+		orders = append(orders, report)
+		cursors = append(cursors, opened)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(orders) <= query.Limit {
+		return orders, 0, nil
+	}
+
+	return orders[:query.Limit], cursors[query.Limit-1], nil
+}
+
+// Trades returns an account's fills, newest first, and the cursor of the
+// next page, zero after the last.
+func (store *HistoryStore) Trades(query HistoryQuery) ([]models.AccountTrade, uint64, error) {
+	where, args := query.where("timestamp", "sequence")
+	rows, err := store.db.Query(`SELECT sequence, account, trade_id, order_id, symbol, side, price, quantity,
+		commission, levy, clearing, vat, sales_tax, timestamp
+		FROM account_trades WHERE `+where+` ORDER BY sequence DESC LIMIT ?`, append(args, query.Limit+1)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var trades []models.AccountTrade
+	var cursors []uint64
+	for rows.Next() {
+		var sequence uint64
+		var trade models.AccountTrade
+		var fees models.Fees
+		var timestamp string
+		if err := rows.Scan(&sequence, &trade.Account, &trade.TradeID, &trade.OrderID, &trade.Symbol, &trade.Side, &trade.Price, &trade.Quantity,
+			&fees.Commission, &fees.Levy, &fees.Clearing, &fees.VAT, &fees.SalesTax, &timestamp); err != nil {
+			return nil, 0, err
+		}
+
+		trade.Fees = &fees
+		if trade.Timestamp, err = time.Parse(sqliteTimeFormat, timestamp); err != nil {
+			return nil, 0, err
+		}
+
+		trades = append(trades, trade)
+		cursors = append(cursors, sequence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(trades) <= query.Limit {
+		return trades, 0, nil
+	}
+
+	return trades[:query.Limit], cursors[query.Limit-1], nil
+}
+
+// ExecutionReports returns an account's reports from a sequence number on,
+// in order.
+func (store *HistoryStore) ExecutionReports(account string, from uint64) ([]models.ExecutionReport, error) {
+	return store.queryReports(`SELECT report FROM execution_reports
+		WHERE account = ? AND sequence >= ? ORDER BY sequence`, account, from)
+}
+
+// LastExecutionSequences returns each account's last report sequence number.
+func (store *HistoryStore) LastExecutionSequences() (map[string]uint64, error) {
+	rows, err := store.db.Query(`SELECT account, MAX(sequence) FROM execution_reports GROUP BY account`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sequences := make(map[string]uint64)
+	for rows.Next() {
+		var account string
+		var sequence uint64
+		if err := rows.Scan(&account, &sequence); err != nil {
+			return nil, err
+		}
+		sequences[account] = sequence
+	}
+
+	return sequences, rows.Err()
+}
+
+// OpenExecutions returns the last report of every order that is still
+// working after it.
+func (store *HistoryStore) OpenExecutions() ([]models.ExecutionReport, error) {
+	return store.queryReports(`SELECT report FROM execution_reports AS last
+		WHERE order_id != '' AND type IN (?, ?, ?)
+		AND sequence = (SELECT MAX(sequence) FROM execution_reports
+			WHERE account = last.account AND order_id = last.order_id)
+		ORDER BY account, sequence`, openExecutionTypes...)
+}
+
+func (store *HistoryStore) queryReports(query string, args ...any) ([]models.ExecutionReport, error) {
+	rows, err := store.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []models.ExecutionReport
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+
+		var report models.ExecutionReport
+		if err := json.Unmarshal([]byte(data), &report); err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
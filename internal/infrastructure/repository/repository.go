@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"market-engine-go/internal/models"
+	"market-engine-go/internal/utils"
+	"time"
+)
+
+// StockRepository stores the daily stock snapshots scraped from IDX. Dates
+// are WIB calendar days.
+type StockRepository interface {
+	// SaveAll stores the snapshot scraped for a trading day.
+	SaveAll(date time.Time, stocks []models.Stock) error
+	// Dates lists the days with a snapshot, oldest first.
+	Dates() ([]time.Time, error)
+	// Load returns the snapshot for the given day, or the latest one when
+	// the date is zero, along with the day it was taken.
+	Load(date time.Time) (time.Time, []models.DailyBar, error)
+	// History returns a symbol's daily bars between from and to inclusive,
+	// oldest first. Zero bounds are open.
+	History(symbol string, from time.Time, to time.Time) ([]models.DailyBar, error)
+
+	DatasetRepository
+}
+
+// DatasetRepository stores the IDX datasets scraped alongside the daily
+// snapshots. Saving a day's data replaces what was saved for that day.
+type DatasetRepository interface {
+	SaveForeignFlows(date time.Time, flows []models.ForeignFlow) error
+	// ForeignFlows returns flows between from and to inclusive, oldest
+	// first. An empty symbol returns every symbol; zero bounds are open.
+	ForeignFlows(symbol string, from time.Time, to time.Time) ([]models.ForeignFlow, error)
+
+	SaveBrokerSummaries(date time.Time, summaries []models.BrokerSummary) error
+	// BrokerSummaries returns the day's broker activity, or the latest
+	// day's when the date is zero.
+	BrokerSummaries(date time.Time) ([]models.BrokerSummary, error)
+
+	SaveIndexConstituents(date time.Time, index string, constituents []models.IndexConstituent) error
+	// IndexConstituents returns the index's constituents as last saved on
+	// or before the date, or the latest when the date is zero.
+	IndexConstituents(index string, date time.Time) ([]models.IndexConstituent, error)
+
+	// SaveCorporateActions adds actions, replacing any with the same code,
+	// type and ex-date.
+	SaveCorporateActions(actions []models.CorporateAction) error
+	// CorporateActions returns actions with an ex-date between from and to
+	// inclusive, oldest first. An empty symbol returns every symbol; zero
+	// bounds are open.
+	CorporateActions(symbol string, from time.Time, to time.Time) ([]models.CorporateAction, error)
+}
+
+const (
+	StorageCSV    = "csv"
+	StorageSQLite = "sqlite"
+)
+
+// Open returns the repository for the given storage. The SQLite database
+// first imports any CSV snapshots in dataDir it does not have yet, so it
+// can be switched to without losing history.
+func Open(storage string, dataDir string, sqlitePath string, format utils.NumberFormat) (StockRepository, error) {
+	csvStore := NewSnapshotStore(dataDir, format)
+
+	switch storage {
+	case StorageCSV:
+		return csvStore, nil
+	case StorageSQLite:
+		database, err := OpenSqliteStockRepository(sqlitePath)
+		if err != nil {
+			return nil, err
+		}
+		database.NumberFormat = format
+
+		imported, err := database.Import(csvStore)
+		if err != nil {
+			database.Close()
+			return nil, fmt.Errorf("importing CSV snapshots: %w", err)
+		}
+		if imported > 0 {
+			log.Printf("[SQLite] Imported %d daily snapshots from %s", imported, dataDir)
+		}
+
+		return database, nil
+	default:
+		return nil, fmt.Errorf("unknown storage %q", storage)
+	}
+}
@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"market-engine-go/internal/models"
+	"market-engine-go/internal/utils"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrNoSnapshot is returned when no daily snapshot matches a request.
+var ErrNoSnapshot = errors.New("no daily snapshot")
+
+// ErrEmptySnapshot is returned when asked to save a snapshot with no usable
+// rows, which would otherwise replace the day's data with nothing.
+var ErrEmptySnapshot = errors.New("snapshot has no usable rows")
+
+// IDX trades on Jakarta time, so snapshot dates are WIB calendar days.
+var jakarta = time.FixedZone("WIB", 7*60*60)
+
+const snapshotDateLayout = "2006-01-02"
+
+// Snapshot files are stocks_idx_2006-01-02.csv. Older scrapes used
+// stocks_idx_2_01_2006.csv, which is still read.
+var (
+	snapshotFilePattern       = regexp.MustCompile(`^stocks_idx_(\d{4})-(\d{2})-(\d{2})\.csv$`)
+	legacySnapshotFilePattern = regexp.MustCompile(`^stocks_idx_(\d{1,2})_(\d{1,2})_(\d{4})\.csv$`)
+)
+
+// SnapshotFileName is the file a daily snapshot for the given day is saved to.
+func SnapshotFileName(date time.Time) string {
+	return fmt.Sprintf("stocks_idx_%s.csv", date.In(jakarta).Format(snapshotDateLayout))
+}
+
+// ParseSnapshotDate parses a YYYY-MM-DD day as used in snapshot names.
+func ParseSnapshotDate(value string) (time.Time, error) {
+	return time.ParseInLocation(snapshotDateLayout, value, jakarta)
+}
+
+// SnapshotStore is the CSV StockRepository. It indexes the daily snapshot
+// files in a directory. The directory is rescanned on every call, so
+// snapshots scraped while the engine runs are picked up; parsed files are
+// cached until they change on disk.
+type SnapshotStore struct {
+	repository *CsvStockRepository
+
+	mu    sync.Mutex
+	cache map[string]cachedSnapshot
+}
+
+// cachedSnapshot is a parsed snapshot with the file state it was read at, so
+// a file rewritten by another process is parsed again.
+type cachedSnapshot struct {
+	modTime time.Time
+	size    int64
+	stocks  []models.DailyBar
+}
+
+func NewSnapshotStore(dir string, format utils.NumberFormat) *SnapshotStore {
+	repository := NewCsvStockRepository(dir)
+	repository.NumberFormat = format
+
+	return &SnapshotStore{
+		repository: repository,
+		cache:      make(map[string]cachedSnapshot),
+	}
+}
+
+func (store *SnapshotStore) SaveAll(date time.Time, stocks []models.Stock) error {
+	if err := store.repository.SaveAll(date, stocks); err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	delete(store.cache, SnapshotFileName(time.Now()))
+	store.mu.Unlock()
+
+	return nil
+}
+
+func (store *SnapshotStore) Dates() ([]time.Time, error) {
+	files, err := store.index()
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedDates(files), nil
+}
+
+func (store *SnapshotStore) Load(date time.Time) (time.Time, []models.DailyBar, error) {
+	files, err := store.index()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	if date.IsZero() {
+		for candidate := range files {
+			if candidate.After(date) {
+				date = candidate
+			}
+		}
+	} else {
+		date = startOfDay(date)
+	}
+
+	filename, exists := files[date]
+	if !exists {
+		return time.Time{}, nil, ErrNoSnapshot
+	}
+
+	stocks, err := store.read(filename, date)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return date, stocks, nil
+}
+
+func (store *SnapshotStore) History(symbol string, from time.Time, to time.Time) ([]models.DailyBar, error) {
+	files, err := store.index()
+	if err != nil {
+		return nil, err
+	}
+
+	var bars []models.DailyBar
+	for _, date := range sortedDates(files) {
+		if !from.IsZero() && date.Before(startOfDay(from)) {
+			continue
+		}
+		if !to.IsZero() && date.After(startOfDay(to)) {
+			continue
+		}
+
+		stocks, err := store.read(files[date], date)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stock := range stocks {
+			if stock.Code == symbol {
+				bars = append(bars, stock)
+				break
+			}
+		}
+	}
+
+	return bars, nil
+}
+
+func (store *SnapshotStore) index() (map[time.Time]string, error) {
+	entries, err := os.ReadDir(store.repository.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[time.Time]string)
+	for _, entry := range entries {
+		date, ok := snapshotFileDate(entry.Name())
+		if !ok {
+			continue
+		}
+
+		// Prefer the current naming when both exist for a day.
+		if _, exists := files[date]; exists && legacySnapshotFilePattern.MatchString(entry.Name()) {
+			continue
+		}
+
+		files[date] = entry.Name()
+	}
+
+	return files, nil
+}
+
+// read parses a snapshot once, logging rejected rows, and stamps its bars
+// with the snapshot day.
+func (store *SnapshotStore) read(filename string, date time.Time) ([]models.DailyBar, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	info, err := os.Stat(filepath.Join(store.repository.Dir, filename))
+	if err != nil {
+		return nil, err
+	}
+
+	cached, exists := store.cache[filename]
+	if exists && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.stocks, nil
+	}
+
+	stocks, report, err := store.repository.ReadStockSnapshotCsv(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(report.Rejected) > 0 {
+		log.Printf("[Snapshots] %v", report)
+		for _, row := range report.Rejected {
+			log.Printf("[Snapshots] %s line %d %s: %s", filename, row.Line, row.Code, row.Reason)
+		}
+	}
+
+	for i := range stocks {
+		stocks[i].Date = date
+	}
+
+	store.cache[filename] = cachedSnapshot{modTime: info.ModTime(), size: info.Size(), stocks: stocks}
+	return stocks, nil
+}
+
+func sortedDates(files map[time.Time]string) []time.Time {
+	dates := make([]time.Time, 0, len(files))
+	for date := range files {
+		dates = append(dates, date)
+	}
+
+	slices.SortFunc(dates, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	return dates
+}
+
+func snapshotFileDate(name string) (time.Time, bool) {
+	var year, month, day string
+	if match := snapshotFilePattern.FindStringSubmatch(name); match != nil {
+		year, month, day = match[1], match[2], match[3]
+	} else if match := legacySnapshotFilePattern.FindStringSubmatch(name); match != nil {
+		day, month, year = match[1], match[2], match[3]
+	} else {
+		return time.Time{}, false
+	}
+
+	y, _ := strconv.Atoi(year)
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	if m < 1 || m > 12 || d < 1 || d > 31 {
+		return time.Time{}, false
+	}
+
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, jakarta), true
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.In(jakarta).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, jakarta)
+}
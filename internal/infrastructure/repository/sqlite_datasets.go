@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"database/sql"
+	"market-engine-go/internal/models"
+	"time"
+)
+
+func (r *SqliteStockRepository) SaveForeignFlows(date time.Time, flows []models.ForeignFlow) error {
+	day := startOfDay(date).Format(snapshotDateLayout)
+
+	return r.replace(`DELETE FROM foreign_flows WHERE date = ?`, []any{day},
+		`INSERT INTO foreign_flows (date, code, buy_volume, sell_volume) VALUES (?, ?, ?, ?)`,
+		len(flows), func(i int) []any {
+			return []any{day, flows[i].Code, flows[i].BuyVolume, flows[i].SellVolume}
+		})
+}
+
+func (r *SqliteStockRepository) ForeignFlows(symbol string, from time.Time, to time.Time) ([]models.ForeignFlow, error) {
+	first, last := dateBounds(from, to)
+
+	rows, err := r.db.Query(`SELECT date, code, buy_volume, sell_volume FROM foreign_flows
+		WHERE (? = '' OR code = ?) AND date BETWEEN ? AND ? ORDER BY date, code`, symbol, symbol, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows(rows, func(rows *sql.Rows) (models.ForeignFlow, error) {
+		var flow models.ForeignFlow
+		var day string
+		if err := rows.Scan(&day, &flow.Code, &flow.BuyVolume, &flow.SellVolume); err != nil {
+			return flow, err
+		}
+
+		flow.Date, err = ParseSnapshotDate(day)
+		return flow, err
+	})
+}
+
+func (r *SqliteStockRepository) SaveBrokerSummaries(date time.Time, summaries []models.BrokerSummary) error {
+	day := startOfDay(date).Format(snapshotDateLayout)
+
+	return r.replace(`DELETE FROM broker_summaries WHERE date = ?`, []any{day},
+		`INSERT INTO broker_summaries (date, broker_code, broker_name, volume, value, frequency) VALUES (?, ?, ?, ?, ?, ?)`,
+		len(summaries), func(i int) []any {
+			summary := summaries[i]
+			return []any{day, summary.BrokerCode, summary.BrokerName, summary.Volume, summary.Value, summary.Frequency}
+		})
+}
+
+func (r *SqliteStockRepository) BrokerSummaries(date time.Time) ([]models.BrokerSummary, error) {
+	query := `SELECT date, broker_code, broker_name, volume, value, frequency FROM broker_summaries
+		WHERE date = (SELECT MAX(date) FROM broker_summaries) ORDER BY broker_code`
+	var args []any
+	if !date.IsZero() {
+		query = `SELECT date, broker_code, broker_name, volume, value, frequency FROM broker_summaries
+			WHERE date = ? ORDER BY broker_code`
+		args = append(args, startOfDay(date).Format(snapshotDateLayout))
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows(rows, func(rows *sql.Rows) (models.BrokerSummary, error) {
+		var summary models.BrokerSummary
+		var day string
+		if err := rows.Scan(&day, &summary.BrokerCode, &summary.BrokerName, &summary.Volume, &summary.Value, &summary.Frequency); err != nil {
+			return summary, err
+		}
+
+		summary.Date, err = ParseSnapshotDate(day)
+		return summary, err
+	})
+}
+
+func (r *SqliteStockRepository) SaveIndexConstituents(date time.Time, index string, constituents []models.IndexConstituent) error {
+	day := startOfDay(date).Format(snapshotDateLayout)
+
+	return r.replace(`DELETE FROM index_constituents WHERE index_code = ? AND date = ?`, []any{index, day},
+		`INSERT INTO index_constituents (date, index_code, code, name, weight) VALUES (?, ?, ?, ?, ?)`,
+		len(constituents), func(i int) []any {
+			constituent := constituents[i]
+			return []any{day, index, constituent.Code, constituent.Name, constituent.Weight}
+		})
+}
+
+func (r *SqliteStockRepository) IndexConstituents(index string, date time.Time) ([]models.IndexConstituent, error) {
+	day := "9999-12-31"
+	if !date.IsZero() {
+		day = startOfDay(date).Format(snapshotDateLayout)
+	}
+
+	rows, err := r.db.Query(`SELECT date, index_code, code, name, weight FROM index_constituents
+		WHERE index_code = ? AND date = (SELECT MAX(date) FROM index_constituents WHERE index_code = ? AND date <= ?)
+		ORDER BY code`, index, index, day)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows(rows, func(rows *sql.Rows) (models.IndexConstituent, error) {
+		var constituent models.IndexConstituent
+		var day string
+		if err := rows.Scan(&day, &constituent.Index, &constituent.Code, &constituent.Name, &constituent.Weight); err != nil {
+			return constituent, err
+		}
+
+		constituent.Date, err = ParseSnapshotDate(day)
+		return constituent, err
+	})
+}
+
+func (r *SqliteStockRepository) SaveCorporateActions(actions []models.CorporateAction) error {
+	return r.replace(``, nil,
+		`INSERT INTO corporate_actions (code, type, ex_date, record_date, payment_date, amount, ratio, price, description)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (code, type, ex_date) DO UPDATE SET
+				record_date = excluded.record_date,
+				payment_date = excluded.payment_date,
+				amount = excluded.amount,
+				ratio = excluded.ratio,
+				price = excluded.price,
+				description = excluded.description`,
+		len(actions), func(i int) []any {
+			action := actions[i]
+			return []any{action.Code, action.Type, formatDate(action.ExDate), formatDate(action.RecordDate), formatDate(action.PaymentDate),
+				action.Amount, action.Ratio, action.Price, action.Description}
+		})
+}
+
+func (r *SqliteStockRepository) CorporateActions(symbol string, from time.Time, to time.Time) ([]models.CorporateAction, error) {
+	first, last := dateBounds(from, to)
+
+	rows, err := r.db.Query(`SELECT code, type, ex_date, record_date, payment_date, amount, ratio, price, description
+		FROM corporate_actions WHERE (? = '' OR code = ?) AND ex_date BETWEEN ? AND ?
+		ORDER BY ex_date, code, type`, symbol, symbol, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows(rows, func(rows *sql.Rows) (models.CorporateAction, error) {
+		var action models.CorporateAction
+		var exDate, recordDate, paymentDate string
+		if err := rows.Scan(&action.Code, &action.Type, &exDate, &recordDate, &paymentDate,
+			&action.Amount, &action.Ratio, &action.Price, &action.Description); err != nil {
+			return action, err
+		}
+
+		var err error
+		for _, date := range []struct {
+			raw    string
+			parsed *time.Time
+		}{{exDate, &action.ExDate}, {recordDate, &action.RecordDate}, {paymentDate, &action.PaymentDate}} {
+			if date.raw == "" {
+				continue
+			}
+			if *date.parsed, err = ParseSnapshotDate(date.raw); err != nil {
+				return action, err
+			}
+		}
+
+		return action, nil
+	})
+}
+
+// replace runs an optional delete and then the insert once per row, in one
+// transaction.
+func (r *SqliteStockRepository) replace(deleteQuery string, deleteArgs []any, insertQuery string, count int, row func(i int) []any) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if deleteQuery != "" {
+		if _, err := tx.Exec(deleteQuery, deleteArgs...); err != nil {
+			return err
+		}
+	}
+
+	insert, err := tx.Prepare(insertQuery)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	for i := range count {
+		if _, err := insert.Exec(row(i)...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func scanRows[T any](rows *sql.Rows, scan func(rows *sql.Rows) (T, error)) ([]T, error) {
+	defer rows.Close()
+
+	var values []T
+	for rows.Next() {
+		value, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, rows.Err()
+}
+
+func dateBounds(from time.Time, to time.Time) (string, string) {
+	first, last := "0000-01-01", "9999-12-31"
+	if !from.IsZero() {
+		first = startOfDay(from).Format(snapshotDateLayout)
+	}
+	if !to.IsZero() {
+		last = startOfDay(to).Format(snapshotDateLayout)
+	}
+
+	return first, last
+}
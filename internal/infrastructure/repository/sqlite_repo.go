@@ -0,0 +1,516 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"market-engine-go/internal/models"
+	"market-engine-go/internal/utils"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// migrations are applied in order and recorded in schema_migrations; append
+// new ones, never edit applied ones.
+var migrations = []string{
+	`CREATE TABLE instruments (
+		code       TEXT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		first_seen TEXT NOT NULL,
+		last_seen  TEXT NOT NULL
+	);
+	CREATE TABLE daily_snapshots (
+		date      TEXT    NOT NULL,
+		code      TEXT    NOT NULL,
+		name      TEXT    NOT NULL,
+		high      REAL    NOT NULL,
+		low       REAL    NOT NULL,
+		close     REAL    NOT NULL,
+		change    REAL    NOT NULL,
+		volume    INTEGER NOT NULL,
+		value     INTEGER NOT NULL,
+		frequency INTEGER NOT NULL,
+		PRIMARY KEY (date, code)
+	);
+	CREATE INDEX daily_snapshots_code ON daily_snapshots (code, date);`,
+
+	`CREATE TABLE trades (
+		id            TEXT PRIMARY KEY,
+		ticker        TEXT    NOT NULL,
+		price         REAL    NOT NULL,
+		size          INTEGER NOT NULL,
+		side          TEXT    NOT NULL,
+		timestamp     TEXT    NOT NULL,
+		buy_order_id  TEXT,
+		sell_order_id TEXT,
+		buyer         TEXT,
+		seller        TEXT
+	);
+	CREATE INDEX trades_ticker_timestamp ON trades (ticker, timestamp);
+	CREATE TABLE candles (
+		ticker TEXT    NOT NULL,
+		start  TEXT    NOT NULL,
+		open   REAL    NOT NULL,
+		high   REAL    NOT NULL,
+		low    REAL    NOT NULL,
+		close  REAL    NOT NULL,
+		volume INTEGER NOT NULL,
+		trades INTEGER NOT NULL,
+		PRIMARY KEY (ticker, start)
+	);`,
+
+	`CREATE TABLE foreign_flows (
+		date        TEXT    NOT NULL,
+		code        TEXT    NOT NULL,
+		buy_volume  INTEGER NOT NULL,
+		sell_volume INTEGER NOT NULL,
+		PRIMARY KEY (date, code)
+	);
+	CREATE INDEX foreign_flows_code ON foreign_flows (code, date);
+	CREATE TABLE broker_summaries (
+		date        TEXT    NOT NULL,
+		broker_code TEXT    NOT NULL,
+		broker_name TEXT    NOT NULL,
+		volume      INTEGER NOT NULL,
+		value       INTEGER NOT NULL,
+		frequency   INTEGER NOT NULL,
+		PRIMARY KEY (date, broker_code)
+	);
+	CREATE TABLE index_constituents (
+		date       TEXT NOT NULL,
+		index_code TEXT NOT NULL,
+		code       TEXT NOT NULL,
+		name       TEXT NOT NULL,
+		weight     REAL NOT NULL,
+		PRIMARY KEY (index_code, date, code)
+	);
+	CREATE TABLE corporate_actions (
+		code         TEXT NOT NULL,
+		type         TEXT NOT NULL,
+		ex_date      TEXT NOT NULL,
+		record_date  TEXT NOT NULL,
+		payment_date TEXT NOT NULL,
+		amount       REAL NOT NULL,
+		ratio        REAL NOT NULL,
+		price        REAL NOT NULL,
+		description  TEXT NOT NULL,
+		PRIMARY KEY (code, type, ex_date)
+	);
+	CREATE INDEX corporate_actions_ex_date ON corporate_actions (ex_date);`,
+}
+
+// sqliteTimeFormat sorts as text and is understood by SQLite's date and time
+// functions.
+const sqliteTimeFormat = "2006-01-02T15:04:05.000Z"
+
+// SqliteStockRepository is a StockRepository in an embedded SQLite
+// database. Besides daily snapshots it keeps the instruments seen in them
+// and, through a TradeRecorder, the engine's trades and one-minute candles.
+type SqliteStockRepository struct {
+	db *sql.DB
+	// NumberFormat is how numbers in scraped snapshots passed to SaveAll are
+	// written.
+	NumberFormat utils.NumberFormat
+}
+
+func OpenSqliteStockRepository(path string) (*SqliteStockRepository, error) {
+	db, err := openSqlite(path, migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqliteStockRepository{db: db, NumberFormat: utils.NumberFormatIDX}, nil
+}
+
+// openSqlite opens the database at path, creating it if needed, and brings
+// its schema up to date.
+func openSqlite(path string, migrations []string) (*sql.DB, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	dsn := "file:" + path + "?" + url.Values{
+		"_pragma": {"journal_mode(WAL)", "busy_timeout(5000)", "foreign_keys(1)"},
+	}.Encode()
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite has a single writer; one connection avoids lock contention.
+	db.SetMaxOpenConns(1)
+
+	if err := migrate(db, migrations); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrating %s: %w", path, err)
+	}
+
+	return db, nil
+}
+
+func (r *SqliteStockRepository) Close() error {
+	return r.db.Close()
+}
+
+func migrate(db *sql.DB, migrations []string) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TEXT NOT NULL
+	)`); err != nil {
+		return err
+	}
+
+	var current int
+	if err := db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return err
+	}
+
+	for version := current + 1; version <= len(migrations); version++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(migrations[version-1]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", version, err)
+		}
+
+		if _, err := tx.Exec(`INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`,
+			version, time.Now().UTC().Format(sqliteTimeFormat)); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+
+		log.Printf("[SQLite] Applied migration %d", version)
+	}
+
+	return nil
+}
+
+// SaveAll stores the snapshot scraped for a day. Rows that fail validation are
+// logged and left out.
+func (r *SqliteStockRepository) SaveAll(date time.Time, stocks []models.Stock) error {
+	bars := make([]models.DailyBar, 0, len(stocks))
+	for _, stock := range stocks {
+		bar, err := ParseStock(stock, r.NumberFormat)
+		if err != nil {
+			log.Printf("[SQLite] Skipping %s: %v", stock.Code, err)
+			continue
+		}
+		bars = append(bars, bar)
+	}
+
+	if len(bars) == 0 {
+		return ErrEmptySnapshot
+	}
+
+	return r.SaveSnapshot(date, bars)
+}
+
+// SaveSnapshot replaces the snapshot for the given day.
+func (r *SqliteStockRepository) SaveSnapshot(date time.Time, bars []models.DailyBar) error {
+	day := startOfDay(date).Format(snapshotDateLayout)
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM daily_snapshots WHERE date = ?`, day); err != nil {
+		return err
+	}
+
+	insert, err := tx.Prepare(`INSERT INTO daily_snapshots
+		(date, code, name, high, low, close, change, volume, value, frequency)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	instrument, err := tx.Prepare(`INSERT INTO instruments (code, name, first_seen, last_seen) VALUES (?, ?, ?, ?)
+		ON CONFLICT (code) DO UPDATE SET
+			name = CASE WHEN excluded.last_seen >= last_seen THEN excluded.name ELSE name END,
+			first_seen = MIN(first_seen, excluded.first_seen),
+			last_seen = MAX(last_seen, excluded.last_seen)`)
+	if err != nil {
+		return err
+	}
+	defer instrument.Close()
+
+	for _, bar := range bars {
+		if _, err := insert.Exec(day, bar.Code, bar.Name, bar.High, bar.Low, bar.Close, bar.Change, bar.Volume, bar.Value, bar.Frequency); err != nil {
+			return fmt.Errorf("%s: %w", bar.Code, err)
+		}
+
+		if _, err := instrument.Exec(bar.Code, bar.Name, day, day); err != nil {
+			return fmt.Errorf("%s: %w", bar.Code, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *SqliteStockRepository) Dates() ([]time.Time, error) {
+	rows, err := r.db.Query(`SELECT DISTINCT date FROM daily_snapshots ORDER BY date`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var dates []time.Time
+	for rows.Next() {
+		var day string
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+
+		date, err := ParseSnapshotDate(day)
+		if err != nil {
+			return nil, err
+		}
+
+		dates = append(dates, date)
+	}
+
+	return dates, rows.Err()
+}
+
+func (r *SqliteStockRepository) Load(date time.Time) (time.Time, []models.DailyBar, error) {
+	var day string
+	if date.IsZero() {
+		var latest sql.NullString
+		if err := r.db.QueryRow(`SELECT MAX(date) FROM daily_snapshots`).Scan(&latest); err != nil {
+			return time.Time{}, nil, err
+		}
+		if !latest.Valid {
+			return time.Time{}, nil, ErrNoSnapshot
+		}
+		day = latest.String
+	} else {
+		day = startOfDay(date).Format(snapshotDateLayout)
+	}
+
+	bars, err := r.queryBars(`SELECT date, code, name, high, low, close, change, volume, value, frequency
+		FROM daily_snapshots WHERE date = ? ORDER BY code`, day)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	if len(bars) == 0 {
+		return time.Time{}, nil, ErrNoSnapshot
+	}
+
+	return bars[0].Date, bars, nil
+}
+
+func (r *SqliteStockRepository) History(symbol string, from time.Time, to time.Time) ([]models.DailyBar, error) {
+	first, last := dateBounds(from, to)
+
+	return r.queryBars(`SELECT date, code, name, high, low, close, change, volume, value, frequency
+		FROM daily_snapshots WHERE code = ? AND date BETWEEN ? AND ? ORDER BY date`, symbol, first, last)
+}
+
+func (r *SqliteStockRepository) queryBars(query string, args ...any) ([]models.DailyBar, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bars []models.DailyBar
+	for rows.Next() {
+		var bar models.DailyBar
+		var day string
+		if err := rows.Scan(&day, &bar.Code, &bar.Name, &bar.High, &bar.Low, &bar.Close, &bar.Change, &bar.Volume, &bar.Value, &bar.Frequency); err != nil {
+			return nil, err
+		}
+
+		bar.Date, err = ParseSnapshotDate(day)
+		if err != nil {
+			return nil, err
+		}
+
+		bars = append(bars, bar)
+	}
+
+	return bars, rows.Err()
+}
+
+// Import copies the snapshots for days this database does not have yet from
+// another repository and returns how many days were copied.
+func (r *SqliteStockRepository) Import(source StockRepository) (int, error) {
+	existing, err := r.Dates()
+	if err != nil {
+		return 0, err
+	}
+
+	have := make(map[string]bool)
+	for _, date := range existing {
+		have[date.Format(snapshotDateLayout)] = true
+	}
+
+	dates, err := source.Dates()
+	if err != nil {
+		return 0, err
+	}
+
+	imported := 0
+	for _, date := range dates {
+		if have[date.Format(snapshotDateLayout)] {
+			continue
+		}
+
+		_, bars, err := source.Load(date)
+		if err != nil {
+			return imported, err
+		}
+
+		if err := r.SaveSnapshot(date, bars); err != nil {
+			return imported, err
+		}
+
+		imported++
+	}
+
+	return imported, nil
+}
+
+// TradeRecorder writes engine trades and their one-minute candles to the
+// database in batches, off the engine's lock.
+type TradeRecorder struct {
+	repository *SqliteStockRepository
+	trades     chan models.Trade
+	done       chan struct{}
+	dropped    int
+}
+
+func (r *SqliteStockRepository) NewTradeRecorder() *TradeRecorder {
+	return &TradeRecorder{
+		repository: r,
+		trades:     make(chan models.Trade, 10000),
+		done:       make(chan struct{}),
+	}
+}
+
+// Record queues a trade. It never blocks, so it can be registered as an
+// engine trade listener; trades are dropped if the writer falls behind.
+func (recorder *TradeRecorder) Record(trade models.Trade) {
+	select {
+	case recorder.trades <- trade:
+	default:
+		recorder.dropped++
+		if recorder.dropped == 1 || recorder.dropped%1000 == 0 {
+			log.Printf("[SQLite] Trade recorder is behind, %d trades dropped", recorder.dropped)
+		}
+	}
+}
+
+// Run writes queued trades until ctx is cancelled, then writes what is left
+// and closes Done.
+func (recorder *TradeRecorder) Run(ctx context.Context) {
+	defer close(recorder.done)
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	var batch []models.Trade
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := recorder.repository.saveTrades(batch); err != nil {
+			log.Printf("[SQLite] Failed to save %d trades: %v", len(batch), err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case trade := <-recorder.trades:
+					batch = append(batch, trade)
+				default:
+					flush()
+					return
+				}
+			}
+		case trade := <-recorder.trades:
+			batch = append(batch, trade)
+			if len(batch) >= 500 {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (recorder *TradeRecorder) Done() <-chan struct{} {
+	return recorder.done
+}
+
+func (r *SqliteStockRepository) saveTrades(trades []models.Trade) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insert, err := tx.Prepare(`INSERT OR IGNORE INTO trades
+		(id, ticker, price, size, side, timestamp, buy_order_id, sell_order_id, buyer, seller)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	candle, err := tx.Prepare(`INSERT INTO candles (ticker, start, open, high, low, close, volume, trades)
+		VALUES (?, ?, ?, ?, ?, ?, ?, 1)
+		ON CONFLICT (ticker, start) DO UPDATE SET
+			high = MAX(high, excluded.high),
+			low = MIN(low, excluded.low),
+			close = excluded.close,
+			volume = volume + excluded.volume,
+			trades = trades + 1`)
+	if err != nil {
+		return err
+	}
+	defer candle.Close()
+
+	for _, trade := range trades {
+		timestamp := trade.Timestamp.UTC()
+
+		result, err := insert.Exec(trade.ID, trade.Ticker, trade.Price, trade.Size, trade.Side, timestamp.Format(sqliteTimeFormat),
+			trade.BuyOrderID, trade.SellOrderID, trade.Buyer, trade.Seller)
+		if err != nil {
+			return fmt.Errorf("%s: %w", trade.ID, err)
+		}
+
+		// A trade already stored has already been counted in its candle.
+		if inserted, _ := result.RowsAffected(); inserted == 0 {
+			continue
+		}
+
+		start := timestamp.Truncate(time.Minute).Format(sqliteTimeFormat)
+		if _, err := candle.Exec(trade.Ticker, start, trade.Price, trade.Price, trade.Price, trade.Price, trade.Size); err != nil {
+			return fmt.Errorf("%s: %w", trade.ID, err)
+		}
+	}
+
+	return tx.Commit()
+}
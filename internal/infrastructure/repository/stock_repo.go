@@ -2,38 +2,74 @@ package repository
 
 import (
 	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"market-engine-go/internal/models"
+	"market-engine-go/internal/utils"
+	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
 type CsvStockRepository struct {
 	Dir string
+	// NumberFormat is how numbers in the files are written. Scraped
+	// snapshots use the IDX locale.
+	NumberFormat utils.NumberFormat
 }
 
 func NewCsvStockRepository(dir string) *CsvStockRepository {
 	_ = os.MkdirAll(dir, os.ModePerm)
-	return &CsvStockRepository{Dir: dir}
+	return &CsvStockRepository{Dir: dir, NumberFormat: utils.NumberFormatIDX}
 }
 
-func (r *CsvStockRepository) SaveAll(stocks []models.Stock) error {
-	filePath := filepath.Join(r.Dir, fmt.Sprintf("stocks_idx_%s.csv", time.Now().Format("2_01_2006")))
-	file, err := os.Create(filePath)
+// SaveAll writes the snapshot for a day. The file is written under a temporary
+// name and renamed into place, so a failed save leaves the previous file
+// for the day untouched.
+func (r *CsvStockRepository) SaveAll(date time.Time, stocks []models.Stock) error {
+	if len(stocks) == 0 {
+		return ErrEmptySnapshot
+	}
+
+	filePath := filepath.Join(r.Dir, SnapshotFileName(date))
+	partialPath := filePath + ".partial"
+
+	file, err := os.Create(partialPath)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
+	defer os.Remove(partialPath)
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+	if err := writeSnapshotCsv(file, stocks); err != nil {
+		file.Close()
+		return fmt.Errorf("writing %s: %w", filePath, err)
+	}
 
-	writer.Write([]string{"code", "name", "high", "low", "close", "change", "volume", "value", "frequency"})
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", filePath, err)
+	}
+
+	if err := os.Rename(partialPath, filePath); err != nil {
+		return err
+	}
+
+	log.Printf("[Snapshots] Saved %d stocks to %s", len(stocks), filePath)
+	return nil
+}
+
+func writeSnapshotCsv(output io.Writer, stocks []models.Stock) error {
+	writer := csv.NewWriter(output)
+
+	if err := writer.Write([]string{"code", "name", "high", "low", "close", "change", "volume", "value", "frequency"}); err != nil {
+		return err
+	}
 
 	for _, s := range stocks {
-		writer.Write([]string{
+		err := writer.Write([]string{
 			s.Code,
 			s.Name,
 			s.High,
@@ -44,44 +80,204 @@ func (r *CsvStockRepository) SaveAll(stocks []models.Stock) error {
 			s.Value,
 			s.Frequency,
 		})
+		if err != nil {
+			return err
+		}
 	}
-	fmt.Printf("CSV saved successfully to: %s\n", filePath)
-	return nil
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// RejectedRow is a snapshot row left out of the result and why.
+type RejectedRow struct {
+	Line   int
+	Code   string
+	Reason string
+}
+
+// ValidationReport summarises how a snapshot file was read.
+type ValidationReport struct {
+	File     string
+	Rows     int
+	Accepted int
+	Rejected []RejectedRow
+}
+
+func (report ValidationReport) String() string {
+	return fmt.Sprintf("%s: %d of %d rows accepted, %d rejected", report.File, report.Accepted, report.Rows, len(report.Rejected))
 }
 
-func (r *CsvStockRepository) ReadStockSnapshotCsv(filename string) ([]models.Stock, error) {
-	file, err := os.Open(fmt.Sprintf("%s/%s", r.Dir, filename))
+// snapshotColumns are the columns a snapshot must have; the others read as
+// zero when missing.
+var snapshotColumns = []string{"code", "close"}
+
+// ReadStockSnapshotCsv reads a snapshot, mapping columns by their header
+// names. Rows with the wrong number of fields, unparsable numbers, a close
+// at or below zero, a high below their low or a repeated code are rejected
+// and listed in the report instead of failing the whole file.
+func (r *CsvStockRepository) ReadStockSnapshotCsv(filename string) ([]models.DailyBar, ValidationReport, error) {
+	report := ValidationReport{File: filename}
 
+	file, err := os.Open(filepath.Join(r.Dir, filename))
 	if err != nil {
-		log.Printf("Error while reading file: %v", err)
-		return nil, err
+		return nil, report, err
 	}
-
 	defer file.Close()
 
 	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
 
-	records, err := reader.ReadAll()
-
+	header, err := reader.Read()
 	if err != nil {
-		log.Printf("Error reading records: %v", err)
-		return nil, err
-	}
-
-	var stocks []models.Stock
-	for _, record := range records {
-		stocks = append(stocks, models.Stock{
-			Code:      record[0],
-			Name:      record[1],
-			High:      record[2],
-			Low:       record[3],
-			Close:     record[4],
-			Change:    record[5],
-			Volume:    record[6],
-			Value:     record[7],
-			Frequency: record[8],
-		})
+		return nil, report, fmt.Errorf("%s: reading header: %w", filename, err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range snapshotColumns {
+		if _, exists := columns[required]; !exists {
+			return nil, report, fmt.Errorf("%s: missing %q column", filename, required)
+		}
+	}
+
+	var stocks []models.DailyBar
+	seen := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		report.Rows++
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			report.Rejected = append(report.Rejected, RejectedRow{Line: parseErr.StartLine, Reason: parseErr.Err.Error()})
+			continue
+		}
+		if err != nil {
+			return nil, report, fmt.Errorf("%s: %w", filename, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if len(record) != len(header) {
+			report.Rejected = append(report.Rejected, RejectedRow{
+				Line:   line,
+				Reason: fmt.Sprintf("row has %d fields, header has %d", len(record), len(header)),
+			})
+			continue
+		}
+
+		stock, err := r.parseSnapshotRow(record, columns)
+		if err == nil {
+			if first, exists := seen[stock.Code]; exists {
+				err = fmt.Errorf("duplicate of line %d", first)
+			}
+		}
+
+		if err != nil {
+			report.Rejected = append(report.Rejected, RejectedRow{Line: line, Code: stock.Code, Reason: err.Error()})
+			continue
+		}
+
+		seen[stock.Code] = line
+		stocks = append(stocks, stock)
+	}
+
+	report.Accepted = len(stocks)
+	return stocks, report, nil
+}
+
+func (r *CsvStockRepository) parseSnapshotRow(record []string, columns map[string]int) (models.DailyBar, error) {
+	// Optional columns that are missing read as zero.
+	field := func(name string) string {
+		index, exists := columns[name]
+		if !exists {
+			return "0"
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	var name string
+	if index, exists := columns["name"]; exists {
+		name = strings.TrimSpace(record[index])
+	}
+
+	stock := models.Stock{
+		Code:      field("code"),
+		Name:      name,
+		High:      field("high"),
+		Low:       field("low"),
+		Close:     field("close"),
+		Change:    field("change"),
+		Volume:    field("volume"),
+		Value:     field("value"),
+		Frequency: field("frequency"),
+	}
+
+	return ParseStock(stock, r.NumberFormat)
+}
+
+// ParseStock converts a scraped stock row into typed numbers and checks
+// that it is usable as reference data.
+func ParseStock(stock models.Stock, format utils.NumberFormat) (models.DailyBar, error) {
+	bar := models.DailyBar{Code: strings.TrimSpace(stock.Code), Name: strings.TrimSpace(stock.Name)}
+	if bar.Code == "" {
+		return bar, fmt.Errorf("missing code")
+	}
+
+	numbers := []struct {
+		name  string
+		raw   string
+		value *float64
+	}{
+		{"high", stock.High, &bar.High},
+		{"low", stock.Low, &bar.Low},
+		{"close", stock.Close, &bar.Close},
+		{"change", stock.Change, &bar.Change},
+	}
+
+	counts := []struct {
+		name  string
+		raw   string
+		value *int64
+	}{
+		{"volume", stock.Volume, &bar.Volume},
+		{"value", stock.Value, &bar.Value},
+		{"frequency", stock.Frequency, &bar.Frequency},
+	}
+
+	for _, number := range numbers {
+		value, err := utils.ParseNumber(number.raw, format)
+		if err != nil {
+			return bar, fmt.Errorf("%s: %w", number.name, err)
+		}
+		*number.value = value
+	}
+
+	for _, count := range counts {
+		value, err := utils.ParseNumber(count.raw, format)
+		if err != nil {
+			return bar, fmt.Errorf("%s: %w", count.name, err)
+		}
+		if value < 0 || value != math.Trunc(value) {
+			return bar, fmt.Errorf("%s: %v is not a whole count", count.name, value)
+		}
+		*count.value = int64(value)
+	}
+
+	if bar.Close <= 0 {
+		return bar, fmt.Errorf("close must be positive, got %v", bar.Close)
+	}
+
+	if bar.High > 0 && bar.Low > 0 && bar.High < bar.Low {
+		return bar, fmt.Errorf("high %v is below low %v", bar.High, bar.Low)
 	}
 
-	return stocks, nil
+	return bar, nil
 }
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchSettle is how long a snapshot file must go unchanged before it is
+// reported, so a file written in several steps is reported once, complete.
+const watchSettle = 2 * time.Second
+
+// Watch calls changed with the day of every snapshot file created or
+// rewritten in the store's directory, until ctx ends. Files the scraper
+// renames into place are reported once; files written in place are
+// reported after they stop changing.
+func (store *SnapshotStore) Watch(ctx context.Context, changed func(date time.Time)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(store.repository.Dir); err != nil {
+		return fmt.Errorf("watching %s: %w", store.repository.Dir, err)
+	}
+
+	log.Printf("[Snapshots] Watching %s for new snapshots", store.repository.Dir)
+
+	pending := make(map[time.Time]time.Time)
+	ticker := time.NewTicker(watchSettle / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+
+			date, ok := snapshotFileDate(filepath.Base(event.Name))
+			if ok {
+				pending[date] = time.Now()
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Printf("[Snapshots] Watch error: %v", err)
+		case now := <-ticker.C:
+			for date, last := range pending {
+				if now.Sub(last) < watchSettle {
+					continue
+				}
+
+				delete(pending, date)
+				changed(date)
+			}
+		}
+	}
+}
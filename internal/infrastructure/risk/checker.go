@@ -0,0 +1,250 @@
+package risk
+
+import (
+	"fmt"
+	"market-engine-go/internal/infrastructure/accounts"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/models"
+	"math"
+	"sync"
+	"time"
+)
+
+// Reasons an order is rejected by a pre-trade check.
+const (
+	ReasonMaxOrderValue    = "MAX_ORDER_VALUE"
+	ReasonMaxOrderQuantity = "MAX_ORDER_QUANTITY"
+	ReasonPriceBand        = "PRICE_BAND"
+	ReasonMaxPosition      = "MAX_POSITION"
+	ReasonDailyLoss        = "DAILY_LOSS"
+	ReasonSelfTrade        = "SELF_TRADE"
+	ReasonRateLimit        = "RATE_LIMIT"
+)
+
+// Rejection is an order failing a pre-trade check.
+type Rejection struct {
+	Reason  string
+	Message string
+}
+
+func (rejection *Rejection) Error() string {
+	return fmt.Sprintf("order rejected (%s): %s", rejection.Reason, rejection.Message)
+}
+
+func reject(reason string, format string, args ...any) error {
+	return &Rejection{Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// Checker runs the pre-trade checks on orders placed for accounts, so one
+// misbehaving client cannot swamp the shared book. Orders of simulated
+// participants are not checked.
+type Checker struct {
+	ledger   *accounts.Ledger
+	settings Settings
+
+	mu sync.Mutex
+	// buckets rate limit each account's orders.
+	buckets map[string]*bucket
+}
+
+// bucket is a token bucket refilled in wall-clock time, since rate limits
+// protect the server however fast the engine clock runs.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func NewChecker(ledger *accounts.Ledger, settings Settings) *Checker {
+	return &Checker{
+		ledger:   ledger,
+		settings: settings,
+		buckets:  make(map[string]*bucket),
+	}
+}
+
+// Check is the engine's PreTradeCheck. Liquidations of accounts in a
+// margin call are not checked.
+func (checker *Checker) Check(order models.Order, market marketengine.MarketView) ([]string, error) {
+	account, err := checker.ledger.Account(order.Owner)
+	if err != nil || !checker.settings.Enabled || order.Liquidation {
+		return nil, nil
+	}
+
+	limits := checker.settings.LimitsFor(account.ID, account.Tier)
+
+	if !checker.allow(account.ID, limits) {
+		return nil, reject(ReasonRateLimit, "more than %g orders a second", limits.OrdersPerSecond)
+	}
+
+	if limits.MaxOrderQuantity > 0 && order.Quantity > limits.MaxOrderQuantity {
+		return nil, reject(ReasonMaxOrderQuantity, "%d shares is over the limit of %d", order.Quantity, limits.MaxOrderQuantity)
+	}
+
+	if cost := market.Cost(order); limits.MaxOrderValue > 0 && cost > limits.MaxOrderValue {
+		return nil, reject(ReasonMaxOrderValue, "%.0f is over the limit of %.0f", cost, limits.MaxOrderValue)
+	}
+
+	crossing := market.Crossing(order)
+	if err := checkPriceBand(order, crossing, market, limits); err != nil {
+		return nil, err
+	}
+
+	if order.Side == models.SideBuy {
+		if err := checkPosition(order, account, market, limits); err != nil {
+			return nil, err
+		}
+	}
+	if opensPosition(order, account) {
+		if err := checker.checkDailyLoss(account, market, limits); err != nil {
+			return nil, err
+		}
+	}
+
+	return selfTrades(order, crossing, limits)
+}
+
+// allow takes a token from the account's bucket.
+func (checker *Checker) allow(accountID string, limits Limits) bool {
+	if limits.OrdersPerSecond <= 0 {
+		return true
+	}
+
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	now := time.Now()
+	capacity := float64(max(limits.Burst, 1))
+
+	tokens, exists := checker.buckets[accountID]
+	if !exists {
+		tokens = &bucket{tokens: capacity, updated: now}
+		checker.buckets[accountID] = tokens
+	}
+
+	tokens.tokens = min(capacity, tokens.tokens+now.Sub(tokens.updated).Seconds()*limits.OrdersPerSecond)
+	tokens.updated = now
+	if tokens.tokens < 1 {
+		return false
+	}
+
+	tokens.tokens--
+	return true
+}
+
+// checkPriceBand keeps a limit price, or the furthest price a market order
+// would fill at, within the band around the last price.
+func checkPriceBand(order models.Order, crossing []models.Order, market marketengine.MarketView, limits Limits) error {
+	if limits.PriceBand <= 0 {
+		return nil
+	}
+
+	lastPrice, exists := market.LastPrice(order.Ticker)
+	if !exists || lastPrice <= 0 {
+		return nil
+	}
+
+	price := order.Price
+	if order.Type == models.OrderTypeMarket {
+		if len(crossing) == 0 {
+			return nil
+		}
+		price = crossing[len(crossing)-1].Price
+	}
+
+	if deviation := math.Abs(price-lastPrice) / lastPrice; deviation > limits.PriceBand {
+		return reject(ReasonPriceBand, "%.0f is %.1f%% from the last price of %.0f, over the %.1f%% band",
+			price, deviation*100, lastPrice, limits.PriceBand*100)
+	}
+
+	return nil
+}
+
+// checkPosition keeps the shares held and being bought within the limit.
+func checkPosition(order models.Order, account models.Account, market marketengine.MarketView, limits Limits) error {
+	if limits.MaxPosition <= 0 {
+		return nil
+	}
+
+	position := order.Quantity
+	for _, held := range account.Positions {
+		if held.Symbol == order.Ticker {
+			position += held.Quantity
+		}
+	}
+	for _, open := range market.OpenOrders(account.ID) {
+		if open.Ticker == order.Ticker && open.Side == models.SideBuy {
+			position += open.Remaining()
+		}
+	}
+
+	if position > limits.MaxPosition {
+		return reject(ReasonMaxPosition, "%s position would be %d shares, over the limit of %d", order.Ticker, position, limits.MaxPosition)
+	}
+
+	return nil
+}
+
+// opensPosition reports whether an order adds to a position: a buy that is
+// not covering a short, or a sale of more than the shares held.
+func opensPosition(order models.Order, account models.Account) bool {
+	held := 0
+	for _, position := range account.Positions {
+		if position.Symbol == order.Ticker {
+			held = position.Quantity
+		}
+	}
+
+	if order.Side == models.SideBuy {
+		return order.Quantity > -held
+	}
+
+	return order.Quantity > held
+}
+
+// checkDailyLoss stops new positions once the day's losses reach the limit.
+// The day's P&L is what was realized on it plus the day's move on the
+// positions held, marked from the reference price rather than their cost so
+// that losses from earlier days do not count against it.
+func (checker *Checker) checkDailyLoss(account models.Account, market marketengine.MarketView, limits Limits) error {
+	if limits.MaxDailyLoss <= 0 {
+		return nil
+	}
+
+	pnl := account.RealizedOn(checker.ledger.TradeDate(market.Now()))
+	for _, position := range account.Positions {
+		lastPrice, exists := market.LastPrice(position.Symbol)
+		if !exists {
+			continue
+		}
+		if reference, exists := market.ReferencePrice(position.Symbol); exists {
+			pnl += (lastPrice - reference) * float64(position.Quantity)
+		}
+	}
+
+	if pnl <= -limits.MaxDailyLoss {
+		return reject(ReasonDailyLoss, "the day's P&L of %.0f has reached the loss limit of %.0f; only orders that close positions are accepted", pnl, limits.MaxDailyLoss)
+	}
+
+	return nil
+}
+
+// selfTrades applies the self-trade policy to the account's resting orders
+// the order would trade against.
+func selfTrades(order models.Order, crossing []models.Order, limits Limits) ([]string, error) {
+	if limits.SelfTrade == SelfTradeAllow {
+		return nil, nil
+	}
+
+	var own []string
+	for _, resting := range crossing {
+		if resting.Owner == order.Owner {
+			own = append(own, resting.ID)
+		}
+	}
+
+	if len(own) > 0 && limits.SelfTrade != SelfTradeCancelResting {
+		return nil, reject(ReasonSelfTrade, "order would trade against resting order %s of the same account", own[0])
+	}
+
+	return own, nil
+}
@@ -0,0 +1,149 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const (
+	// SelfTradeReject rejects an order that would trade against a resting
+	// order of the same account.
+	SelfTradeReject = "reject"
+	// SelfTradeCancelResting cancels the account's resting orders the order
+	// would trade against, then matches it.
+	SelfTradeCancelResting = "cancel_resting"
+	// SelfTradeAllow lets accounts trade with themselves.
+	SelfTradeAllow = "allow"
+)
+
+// Limits are the pre-trade checks on an account's orders. A limit applies
+// when it is positive.
+type Limits struct {
+	// MaxOrderValue is in rupiah, at the limit price or, for market orders,
+	// the prices the order would fill at.
+	MaxOrderValue float64 `json:"max_order_value"`
+	// MaxOrderQuantity is in shares.
+	MaxOrderQuantity int `json:"max_order_quantity"`
+	// PriceBand is how far from the last price, as a fraction of it, a
+	// limit price or a market order's furthest fill may be.
+	PriceBand float64 `json:"price_band"`
+	// MaxPosition is the most shares of one symbol an account may hold,
+	// counting its open buy orders.
+	MaxPosition int `json:"max_position"`
+	// MaxDailyLoss is in rupiah: once the day's realized P&L plus the
+	// day's move on open positions, from the reference price, falls below
+	// its negative, only orders that close positions are accepted.
+	MaxDailyLoss float64 `json:"max_daily_loss"`
+	// SelfTrade is reject, cancel_resting or allow.
+	SelfTrade string `json:"self_trade"`
+	// OrdersPerSecond and Burst rate limit orders, including rejected ones,
+	// with a token bucket.
+	OrdersPerSecond float64 `json:"orders_per_second"`
+	Burst           int     `json:"burst"`
+}
+
+// Settings are the default limits, overridden per fee tier and then per
+// account. Fields an override leaves out, or sets to zero, keep the value
+// they override; a negative value lifts a limit.
+type Settings struct {
+	Enabled  bool              `json:"enabled"`
+	Default  Limits            `json:"default"`
+	Tiers    map[string]Limits `json:"tiers"`
+	Accounts map[string]Limits `json:"accounts"`
+}
+
+// DefaultSettings follow the IDX order limit of 50,000 lots and keep limit
+// prices within 20% of the last price.
+func DefaultSettings() Settings {
+	return Settings{
+		Enabled: true,
+		Default: Limits{
+			MaxOrderValue:    10_000_000_000,
+			MaxOrderQuantity: 5_000_000,
+			PriceBand:        0.2,
+			SelfTrade:        SelfTradeReject,
+			OrdersPerSecond:  10,
+			Burst:            20,
+		},
+	}
+}
+
+// LoadSettings reads a JSON settings file. Fields missing from the file keep
+// their default values.
+func LoadSettings(path string) (Settings, error) {
+	settings := DefaultSettings()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, err
+	}
+
+	for name, limits := range settings.allLimits() {
+		switch limits.SelfTrade {
+		case "", SelfTradeReject, SelfTradeCancelResting, SelfTradeAllow:
+		default:
+			return settings, fmt.Errorf("%s: unknown self_trade policy %q", name, limits.SelfTrade)
+		}
+	}
+
+	return settings, nil
+}
+
+func (settings Settings) allLimits() map[string]Limits {
+	all := map[string]Limits{"default": settings.Default}
+	for tier, limits := range settings.Tiers {
+		all["tier "+tier] = limits
+	}
+	for account, limits := range settings.Accounts {
+		all["account "+account] = limits
+	}
+
+	return all
+}
+
+// LimitsFor returns the limits of an account on a fee tier.
+func (settings Settings) LimitsFor(accountID string, tier string) Limits {
+	limits := settings.Default
+	if override, exists := settings.Tiers[tier]; exists {
+		limits = override.mergedWith(limits)
+	}
+	if override, exists := settings.Accounts[accountID]; exists {
+		limits = override.mergedWith(limits)
+	}
+
+	return limits
+}
+
+func (limits Limits) mergedWith(fallback Limits) Limits {
+	if limits.MaxOrderValue == 0 {
+		limits.MaxOrderValue = fallback.MaxOrderValue
+	}
+	if limits.MaxOrderQuantity == 0 {
+		limits.MaxOrderQuantity = fallback.MaxOrderQuantity
+	}
+	if limits.PriceBand == 0 {
+		limits.PriceBand = fallback.PriceBand
+	}
+	if limits.MaxPosition == 0 {
+		limits.MaxPosition = fallback.MaxPosition
+	}
+	if limits.MaxDailyLoss == 0 {
+		limits.MaxDailyLoss = fallback.MaxDailyLoss
+	}
+	if limits.SelfTrade == "" {
+		limits.SelfTrade = fallback.SelfTrade
+	}
+	if limits.OrdersPerSecond == 0 {
+		limits.OrdersPerSecond = fallback.OrdersPerSecond
+	}
+	if limits.Burst == 0 {
+		limits.Burst = fallback.Burst
+	}
+
+	return limits
+}
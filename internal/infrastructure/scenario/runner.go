@@ -0,0 +1,312 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"log"
+	marketengine "market-engine-go/internal/infrastructure/market-engine"
+	"market-engine-go/internal/models"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+)
+
+// owner tags the orders a scenario submits into the book.
+const owner = "SCENARIO"
+
+const defaultLots = 10
+
+// Runner applies a scenario's events to the engine on its clock.
+type Runner struct {
+	engine   *marketengine.MarketEngine
+	scenario Scenario
+}
+
+type scheduledEvent struct {
+	Event
+	at      time.Time
+	symbols []string
+}
+
+// effect is an event that has started and may need further steps until it
+// completes. stop undoes whatever should not outlive a cancelled scenario.
+type effect interface {
+	step(now time.Time) bool
+	stop()
+}
+
+func NewRunner(engine *marketengine.MarketEngine, scenario Scenario) *Runner {
+	return &Runner{engine: engine, scenario: scenario}
+}
+
+// Check resolves every event's time and symbols without applying anything,
+// so a bad scenario can be rejected before it starts.
+func (runner *Runner) Check() error {
+	start, err := runner.scenario.startTime(runner.engine.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = runner.schedule(start)
+	return err
+}
+
+// Run blocks until every event has completed or ctx is cancelled. When the
+// scenario sets a start time or speed, the engine is switched to a simulated
+// clock first.
+func (runner *Runner) Run(ctx context.Context) error {
+	start, err := runner.scenario.startTime(runner.engine.Now())
+	if err != nil {
+		return err
+	}
+
+	if runner.scenario.Start != "" || runner.scenario.Speed > 0 {
+		runner.engine.SetClock(marketengine.NewSimulatedClock(start, runner.scenario.Speed))
+	}
+
+	pending, err := runner.schedule(start)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[Scenario] %q started with %d events", runner.scenario.Name, len(pending))
+
+	var active []effect
+	defer func() {
+		for _, running := range active {
+			running.stop()
+		}
+	}()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for len(pending) > 0 || len(active) > 0 {
+		select {
+		case <-ctx.Done():
+			log.Printf("[Scenario] %q stopped", runner.scenario.Name)
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		now := runner.engine.Now()
+		for len(pending) > 0 && !pending[0].at.After(now) {
+			event := pending[0]
+			pending = pending[1:]
+
+			log.Printf("[Scenario] %s on %v", event.Type, event.symbols)
+			active = append(active, runner.begin(event))
+		}
+
+		active = slices.DeleteFunc(active, func(running effect) bool {
+			return running.step(now)
+		})
+	}
+
+	log.Printf("[Scenario] %q completed", runner.scenario.Name)
+	return nil
+}
+
+func (runner *Runner) schedule(start time.Time) ([]scheduledEvent, error) {
+	known := make(map[string]bool)
+	all := runner.engine.Symbols()
+	for _, symbol := range all {
+		known[symbol] = true
+	}
+
+	events := make([]scheduledEvent, 0, len(runner.scenario.Events))
+	for i, event := range runner.scenario.Events {
+		at, err := event.eventTime(start)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", i+1, err)
+		}
+
+		symbols := slices.Clone(event.Symbols)
+		switch {
+		case event.Sector == SectorAll:
+			symbols = append(symbols, all...)
+		case event.Sector != "":
+			members, exists := runner.scenario.Sectors[event.Sector]
+			if !exists {
+				return nil, fmt.Errorf("event %d: unknown sector %q", i+1, event.Sector)
+			}
+			symbols = append(symbols, members...)
+		}
+
+		for _, symbol := range symbols {
+			if !known[symbol] {
+				return nil, fmt.Errorf("event %d: %w: %s", i+1, marketengine.ErrUnknownSymbol, symbol)
+			}
+		}
+
+		slices.Sort(symbols)
+		events = append(events, scheduledEvent{Event: event, at: at, symbols: slices.Compact(symbols)})
+	}
+
+	slices.SortStableFunc(events, func(a, b scheduledEvent) int {
+		return a.at.Compare(b.at)
+	})
+
+	return events, nil
+}
+
+func (runner *Runner) begin(event scheduledEvent) effect {
+	switch event.Type {
+	case EventMove:
+		return runner.beginMove(event)
+	case EventVolatility:
+		return runner.beginVolatility(event)
+	case EventHalt:
+		return runner.beginHalt(event)
+	default:
+		return runner.beginFlood(event)
+	}
+}
+
+func (runner *Runner) submit(symbol string, side string, lots int) {
+	if lots <= 0 {
+		lots = defaultLots
+	}
+
+	_, _, err := runner.engine.SubmitOrder(models.Order{
+		Owner:    owner,
+		Ticker:   symbol,
+		Side:     side,
+		Type:     models.OrderTypeMarket,
+		Quantity: lots * models.LotSize,
+	})
+	if err != nil {
+		log.Printf("[Scenario] Order on %s rejected: %v", symbol, err)
+	}
+}
+
+func progress(event scheduledEvent, now time.Time) float64 {
+	if event.Duration <= 0 {
+		return 1
+	}
+
+	return min(float64(now.Sub(event.at))/float64(event.Duration), 1)
+}
+
+// moveEffect walks each symbol's fundamental along a straight line to the
+// target change and pushes the last price after it with market orders.
+type moveEffect struct {
+	runner *Runner
+	event  scheduledEvent
+	bases  map[string]float64
+}
+
+func (runner *Runner) beginMove(event scheduledEvent) effect {
+	bases := make(map[string]float64, len(event.symbols))
+	for _, symbol := range event.symbols {
+		if base, exists := runner.engine.Fundamental(symbol); exists {
+			bases[symbol] = base
+		}
+	}
+
+	return &moveEffect{runner: runner, event: event, bases: bases}
+}
+
+func (move *moveEffect) step(now time.Time) bool {
+	done := progress(move.event, now)
+	engine := move.runner.engine
+
+	for symbol, base := range move.bases {
+		target := base * (1 + move.event.ChangePct/100*done)
+		if err := engine.SetFundamental(symbol, target); err != nil {
+			continue
+		}
+
+		last, _ := engine.LastPrice(symbol)
+		tick := marketengine.TickSize(last)
+
+		switch {
+		case move.event.ChangePct < 0 && last > target+tick:
+			move.runner.submit(symbol, models.SideSell, move.event.Lots)
+		case move.event.ChangePct > 0 && last < target-tick:
+			move.runner.submit(symbol, models.SideBuy, move.event.Lots)
+		}
+	}
+
+	return done >= 1
+}
+
+func (move *moveEffect) stop() {}
+
+type volatilityEffect struct {
+	runner *Runner
+	event  scheduledEvent
+}
+
+func (runner *Runner) beginVolatility(event scheduledEvent) effect {
+	for _, symbol := range event.symbols {
+		runner.engine.SetVolatility(symbol, event.Multiplier)
+	}
+
+	return &volatilityEffect{runner: runner, event: event}
+}
+
+func (volatility *volatilityEffect) step(now time.Time) bool {
+	if volatility.event.Duration <= 0 {
+		return true
+	}
+
+	if progress(volatility.event, now) < 1 {
+		return false
+	}
+
+	volatility.stop()
+	return true
+}
+
+func (volatility *volatilityEffect) stop() {
+	for _, symbol := range volatility.event.symbols {
+		volatility.runner.engine.SetVolatility(symbol, 1)
+	}
+}
+
+type haltEffect struct{}
+
+// beginHalt halts the symbols until the end of the event. The engine lifts
+// timed halts on its own, so there is nothing left to step.
+func (runner *Runner) beginHalt(event scheduledEvent) effect {
+	var until time.Time
+	if event.Duration > 0 {
+		until = event.at.Add(time.Duration(event.Duration))
+	}
+
+	for _, symbol := range event.symbols {
+		runner.engine.Halt(symbol, until)
+	}
+
+	return haltEffect{}
+}
+
+func (haltEffect) step(time.Time) bool { return true }
+
+func (haltEffect) stop() {}
+
+// floodEffect spreads a burst of market orders evenly over the event.
+type floodEffect struct {
+	runner *Runner
+	event  scheduledEvent
+	side   string
+	sent   int
+}
+
+func (runner *Runner) beginFlood(event scheduledEvent) effect {
+	return &floodEffect{runner: runner, event: event, side: strings.ToUpper(event.Side)}
+}
+
+func (flood *floodEffect) step(now time.Time) bool {
+	due := int(float64(flood.event.Orders) * progress(flood.event, now))
+	for ; flood.sent < due; flood.sent++ {
+		symbol := flood.event.symbols[rand.IntN(len(flood.event.symbols))]
+		flood.runner.submit(symbol, flood.side, flood.event.Lots)
+	}
+
+	return flood.sent >= flood.event.Orders
+}
+
+func (flood *floodEffect) stop() {}
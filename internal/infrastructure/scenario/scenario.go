@@ -0,0 +1,171 @@
+package scenario
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	EventMove       = "move"
+	EventVolatility = "volatility"
+	EventHalt       = "halt"
+	EventFlood      = "flood"
+)
+
+// SectorAll selects every symbol loaded in the engine.
+const SectorAll = "all"
+
+// jakarta is the exchange time zone that "HH:MM" event times refer to.
+var jakarta = time.FixedZone("WIB", 7*60*60)
+
+// Scenario is a declarative schedule of market events. Files may be written
+// in YAML or JSON.
+type Scenario struct {
+	Name string `yaml:"name"`
+	// Start is the simulated time the scenario begins at, either RFC 3339 or
+	// "HH:MM" on the current day. When empty the engine's current time is used.
+	Start string `yaml:"start"`
+	// Speed runs the simulated clock faster than wall-clock time.
+	Speed float64 `yaml:"speed"`
+	// Sectors names groups of symbols that events can target.
+	Sectors map[string][]string `yaml:"sectors"`
+	Events  []Event             `yaml:"events"`
+}
+
+type Event struct {
+	// At is "HH:MM", "HH:MM:SS" or an offset from the start such as "+15m".
+	At   string `yaml:"at"`
+	Type string `yaml:"type"`
+	// Symbols and Sector select the symbols the event applies to.
+	Symbols []string `yaml:"symbols"`
+	Sector  string   `yaml:"sector"`
+	// Duration spreads a move or flood over time and bounds halts and
+	// volatility changes. Without it moves are instant shocks and halts last
+	// until resumed.
+	Duration Duration `yaml:"duration"`
+	// ChangePct is the move in percent, negative for a drop.
+	ChangePct float64 `yaml:"change_pct"`
+	// Multiplier scales the symbols' volatility.
+	Multiplier float64 `yaml:"multiplier"`
+	// Side, Orders and Lots describe a flood of market orders. Moves use Lots
+	// for the orders that push the price along its path.
+	Side   string `yaml:"side"`
+	Orders int    `yaml:"orders"`
+	Lots   int    `yaml:"lots"`
+}
+
+// Duration accepts Go duration strings such as "10m" or "1h30m".
+type Duration time.Duration
+
+func (duration *Duration) UnmarshalYAML(node *yaml.Node) error {
+	parsed, err := time.ParseDuration(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", node.Line, err)
+	}
+
+	*duration = Duration(parsed)
+	return nil
+}
+
+// Parse reads a YAML or JSON scenario definition.
+func Parse(data []byte) (Scenario, error) {
+	var scenario Scenario
+	if err := yaml.Unmarshal(data, &scenario); err != nil {
+		return scenario, err
+	}
+
+	for i, event := range scenario.Events {
+		if err := event.validate(); err != nil {
+			return scenario, fmt.Errorf("event %d: %w", i+1, err)
+		}
+	}
+
+	return scenario, nil
+}
+
+func Load(path string) (Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+
+	return Parse(data)
+}
+
+func (event Event) validate() error {
+	if len(event.Symbols) == 0 && event.Sector == "" {
+		return fmt.Errorf("%s event needs symbols or a sector", event.Type)
+	}
+
+	switch event.Type {
+	case EventMove:
+		if event.ChangePct == 0 {
+			return fmt.Errorf("move event needs change_pct")
+		}
+	case EventVolatility:
+		if event.Multiplier <= 0 {
+			return fmt.Errorf("volatility event needs a positive multiplier")
+		}
+	case EventHalt:
+	case EventFlood:
+		side := strings.ToUpper(event.Side)
+		if side != "BUY" && side != "SELL" {
+			return fmt.Errorf("flood event side must be BUY or SELL")
+		}
+		if event.Orders <= 0 {
+			return fmt.Errorf("flood event needs a positive number of orders")
+		}
+	default:
+		return fmt.Errorf("unknown event type %q", event.Type)
+	}
+
+	return nil
+}
+
+// startTime resolves the scenario's start against the engine's current time.
+func (scenario Scenario) startTime(now time.Time) (time.Time, error) {
+	if scenario.Start == "" {
+		return now, nil
+	}
+
+	if start, err := time.Parse(time.RFC3339, scenario.Start); err == nil {
+		return start, nil
+	}
+
+	return timeOfDay(now.In(jakarta), scenario.Start)
+}
+
+// eventTime resolves an event's At field against the scenario start.
+func (event Event) eventTime(start time.Time) (time.Time, error) {
+	if event.At == "" {
+		return start, nil
+	}
+
+	if offset, found := strings.CutPrefix(event.At, "+"); found {
+		duration, err := time.ParseDuration(offset)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return start.Add(duration), nil
+	}
+
+	return timeOfDay(start.In(jakarta), event.At)
+}
+
+func timeOfDay(day time.Time, value string) (time.Time, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		clock, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+
+		return time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, day.Location()), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, expected HH:MM, HH:MM:SS or +duration", value)
+}
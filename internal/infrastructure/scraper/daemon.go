@@ -0,0 +1,225 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"market-engine-go/internal/infrastructure/calendar"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const dayLayout = "2006-01-02"
+
+type DaemonOptions struct {
+	// RunAt is the time of day in WIB scrapes start at. The trading
+	// summary is final once post-trading ends at 16:15.
+	RunAt time.Duration
+	// Attempts is how many scheduled runs a trading day gets before the
+	// daemon gives up on it.
+	Attempts int
+	// RetryInterval is the wait after a failed run before the next one.
+	RetryInterval time.Duration
+	// NotifyTimeout bounds telling the market engine to reload.
+	NotifyTimeout time.Duration
+}
+
+func DefaultDaemonOptions() DaemonOptions {
+	return DaemonOptions{
+		RunAt:         16*time.Hour + 30*time.Minute,
+		Attempts:      3,
+		RetryInterval: 20 * time.Minute,
+		NotifyTimeout: 30 * time.Second,
+	}
+}
+
+// Notifier is told about every snapshot the daemon saves.
+type Notifier interface {
+	Notify(ctx context.Context, day time.Time) error
+}
+
+// Daemon scrapes once per trading day after the IDX close. Days that
+// already have a successful run in the history are skipped, so restarting
+// the daemon does not scrape again.
+type Daemon struct {
+	scraper  *Scraper
+	calendar *calendar.Calendar
+	history  *History
+	options  DaemonOptions
+	// Notifier, when set, is told about every saved snapshot.
+	Notifier Notifier
+
+	mu      sync.Mutex
+	running bool
+	nextRun time.Time
+}
+
+func NewDaemon(scraper *Scraper, calendar *calendar.Calendar, history *History, options DaemonOptions) *Daemon {
+	return &Daemon{
+		scraper:  scraper,
+		calendar: calendar,
+		history:  history,
+		options:  options,
+	}
+}
+
+// Run schedules scrapes until ctx ends. A scrape interrupted by shutdown
+// is not recorded, so it runs again after a restart.
+func (daemon *Daemon) Run(ctx context.Context) {
+	for {
+		day, at := daemon.schedule(time.Now())
+
+		daemon.mu.Lock()
+		daemon.nextRun = at
+		daemon.mu.Unlock()
+
+		log.Printf("[Daemon] Next scrape of %s at %s", day.Format(dayLayout), at.In(calendar.Jakarta).Format(time.DateTime))
+
+		timer := time.NewTimer(time.Until(at))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		daemon.run(ctx, day)
+	}
+}
+
+// schedule returns the next trading day, from today on, that still needs a
+// run, and when that run is due.
+func (daemon *Daemon) schedule(now time.Time) (time.Time, time.Time) {
+	day := calendar.Day(now)
+	if !daemon.calendar.IsTradingDay(day) {
+		day = daemon.calendar.NextTradingDay(day)
+	}
+
+	for {
+		runs := daemon.history.Day(day.Format(dayLayout))
+		if !daemon.settled(runs) {
+			at := day.Add(daemon.options.RunAt)
+			if len(runs) > 0 {
+				retry := runs[len(runs)-1].Finished.Add(daemon.options.RetryInterval)
+				if retry.After(at) {
+					at = retry
+				}
+			}
+
+			if at.Before(now) {
+				at = now
+			}
+			return day, at
+		}
+
+		day = daemon.calendar.NextTradingDay(day)
+	}
+}
+
+// settled reports whether a day needs no more runs: one succeeded or it
+// has used all its attempts.
+func (daemon *Daemon) settled(runs []RunRecord) bool {
+	for _, run := range runs {
+		if run.Succeeded() {
+			return true
+		}
+	}
+
+	return len(runs) >= daemon.options.Attempts
+}
+
+func (daemon *Daemon) run(ctx context.Context, day time.Time) {
+	daemon.mu.Lock()
+	daemon.running = true
+	daemon.mu.Unlock()
+
+	defer func() {
+		daemon.mu.Lock()
+		daemon.running = false
+		daemon.mu.Unlock()
+	}()
+
+	record := RunRecord{
+		Day:     day.Format(dayLayout),
+		Attempt: len(daemon.history.Day(day.Format(dayLayout))) + 1,
+		Started: time.Now(),
+	}
+
+	result, err := daemon.scraper.Run(ctx, day)
+	if ctx.Err() != nil {
+		log.Printf("[Daemon] Scrape of %s interrupted", record.Day)
+		return
+	}
+
+	record.Finished = time.Now()
+	record.Listed = result.Listed
+	record.Summarised = result.Summarised
+	record.Usable = result.Usable
+	record.Unmatched = result.Unmatched
+	record.Unlisted = result.Unlisted
+	record.ParseErrors = result.ParseErrors
+	record.Fetches = result.Attempts
+	record.Datasets = result.Datasets
+	record.DatasetErrors = result.DatasetErrors
+
+	if err != nil {
+		record.Error = err.Error()
+		log.Printf("[Daemon] Scrape of %s failed (attempt %d of %d): %v", record.Day, record.Attempt, daemon.options.Attempts, err)
+	} else if daemon.Notifier != nil {
+		notifyCtx, cancel := context.WithTimeout(ctx, daemon.options.NotifyTimeout)
+		if err := daemon.Notifier.Notify(notifyCtx, day); err != nil {
+			record.NotifyError = err.Error()
+			log.Printf("[Daemon] Failed to notify the market engine: %v", err)
+		} else {
+			record.Notified = true
+		}
+		cancel()
+	}
+
+	if err := daemon.history.Append(record); err != nil {
+		log.Printf("[Daemon] Failed to record run: %v", err)
+	}
+}
+
+// DaemonStatus is what the status endpoint reports.
+type DaemonStatus struct {
+	Running     bool        `json:"running"`
+	NextRun     time.Time   `json:"next_run"`
+	LastRun     *RunRecord  `json:"last_run,omitempty"`
+	LastSuccess *RunRecord  `json:"last_success,omitempty"`
+	Runs        []RunRecord `json:"runs"`
+}
+
+func (daemon *Daemon) Status() DaemonStatus {
+	daemon.mu.Lock()
+	status := DaemonStatus{Running: daemon.running, NextRun: daemon.nextRun}
+	daemon.mu.Unlock()
+
+	status.Runs = daemon.history.Runs()
+	for i := len(status.Runs) - 1; i >= 0; i-- {
+		run := status.Runs[i]
+		if status.LastRun == nil {
+			status.LastRun = &run
+		}
+		if run.Succeeded() {
+			status.LastSuccess = &run
+			break
+		}
+	}
+
+	return status
+}
+
+// ServeHTTP reports the status as JSON.
+func (daemon *Daemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(daemon.Status()); err != nil {
+		log.Printf("[Daemon] Failed to write status: %v", err)
+	}
+}
@@ -0,0 +1,388 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"market-engine-go/internal/infrastructure/calendar"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Dataset is an IDX dataset scraped alongside the daily snapshot. Foreign
+// flows are not listed: they come from the trading summary the snapshot is
+// built from.
+type Dataset string
+
+const (
+	DatasetBrokerSummary     Dataset = "broker_summary"
+	DatasetIndexConstituents Dataset = "index_constituents"
+	DatasetDividends         Dataset = "dividends"
+	DatasetStockSplits       Dataset = "stock_splits"
+	DatasetRightsIssues      Dataset = "rights_issues"
+)
+
+func DefaultDatasets() []Dataset {
+	return []Dataset{DatasetBrokerSummary, DatasetIndexConstituents, DatasetDividends, DatasetStockSplits, DatasetRightsIssues}
+}
+
+func ParseDataset(value string) (Dataset, error) {
+	for _, dataset := range DefaultDatasets() {
+		if string(dataset) == value {
+			return dataset, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown dataset %q", value)
+}
+
+// DefaultIndices are the indices whose constituents are scraped: the main
+// liquidity indices and the IDX-IC sector indices, which classify stocks by
+// sector.
+func DefaultIndices() []string {
+	return append([]string{"LQ45", "IDX30", "IDX80"}, slices.Sorted(maps.Keys(models.SectorIndices))...)
+}
+
+// DatasetRequest asks a fetcher for one dataset page.
+type DatasetRequest struct {
+	Dataset Dataset
+	Date    time.Time
+	// Index is the index code for DatasetIndexConstituents, such as LQ45.
+	Index string
+}
+
+// name identifies the request in logs, results and fixture file names.
+func (request DatasetRequest) name() string {
+	if request.Index != "" {
+		return string(request.Dataset) + "_" + request.Index
+	}
+	return string(request.Dataset)
+}
+
+// datasetEndpoint is the JSON endpoint IDX serves a dataset from, and the
+// page that calls it.
+func datasetEndpoint(request DatasetRequest) (string, url.Values, string, error) {
+	query := url.Values{
+		"start":  {"0"},
+		"length": {"9999"},
+	}
+	day := request.Date.Format("20060102")
+
+	switch request.Dataset {
+	case DatasetBrokerSummary:
+		query.Set("date", day)
+		return "/primary/TradingSummary/GetBrokerSummary", query, brokerSummaryPageURL, nil
+	case DatasetIndexConstituents:
+		query.Set("indexCode", request.Index)
+		return "/primary/StockData/GetIndexConstituent", query, indexPageURL, nil
+	case DatasetDividends:
+		query.Set("date", day)
+		return "/primary/ListedCompany/GetDividend", query, corporateActionPageURL, nil
+	case DatasetStockSplits:
+		query.Set("date", day)
+		return "/primary/ListedCompany/GetStockSplit", query, corporateActionPageURL, nil
+	case DatasetRightsIssues:
+		query.Set("date", day)
+		return "/primary/ListedCompany/GetRightIssue", query, corporateActionPageURL, nil
+	default:
+		return "", nil, "", fmt.Errorf("unknown dataset %q", request.Dataset)
+	}
+}
+
+// scrapeDatasets fetches, parses and saves every configured dataset. A
+// failed dataset is recorded in the result and does not stop the others or
+// fail the run, since the snapshot is already saved.
+func (scraper *Scraper) scrapeDatasets(ctx context.Context, date time.Time, summaryPage Page, result *Result) {
+	result.Datasets = make(map[string]int)
+
+	record := func(name string, saved int, err error) {
+		if err != nil {
+			result.DatasetErrors = append(result.DatasetErrors, fmt.Sprintf("%s: %v", name, err))
+			log.Printf("[Scraper] Failed to scrape %s: %v", name, err)
+			return
+		}
+		result.Datasets[name] = saved
+		log.Printf("[Scraper] Saved %d %s rows", saved, name)
+	}
+
+	flows, err := parseForeignFlows(summaryPage, date)
+	if err == nil && len(flows) > 0 {
+		err = scraper.Repository.SaveForeignFlows(date, flows)
+	}
+	record("foreign_flows", len(flows), err)
+
+	var requests []DatasetRequest
+	for _, dataset := range scraper.Datasets {
+		if dataset != DatasetIndexConstituents {
+			requests = append(requests, DatasetRequest{Dataset: dataset, Date: date})
+			continue
+		}
+
+		for _, index := range scraper.Indices {
+			requests = append(requests, DatasetRequest{Dataset: dataset, Date: date, Index: index})
+		}
+	}
+
+	for _, request := range requests {
+		if ctx.Err() != nil {
+			return
+		}
+
+		saved, err := scraper.scrapeDataset(ctx, request, result)
+		record(request.name(), saved, err)
+	}
+}
+
+func (scraper *Scraper) scrapeDataset(ctx context.Context, request DatasetRequest, result *Result) (int, error) {
+	var page Page
+	tries, err := scraper.Retry.retry(ctx, request.name(), func() error {
+		var err error
+		page, err = scraper.Fetcher.FetchDataset(ctx, request)
+		return err
+	})
+	result.Attempts += tries
+	if err != nil {
+		return 0, fmt.Errorf("fetching: %w", err)
+	}
+
+	if page.Format != PageJSON {
+		return 0, fmt.Errorf("%s pages cannot be parsed, only JSON", page.Format)
+	}
+
+	date := calendar.Day(request.Date)
+
+	switch request.Dataset {
+	case DatasetBrokerSummary:
+		summaries, err := parseBrokerSummary(page.Body, date)
+		if err == nil {
+			err = scraper.Repository.SaveBrokerSummaries(date, summaries)
+		}
+		return len(summaries), err
+	case DatasetIndexConstituents:
+		constituents, err := parseIndexConstituents(page.Body, date, request.Index)
+		if err == nil {
+			err = scraper.Repository.SaveIndexConstituents(date, request.Index, constituents)
+		}
+		return len(constituents), err
+	case DatasetDividends, DatasetStockSplits, DatasetRightsIssues:
+		actions, err := parseCorporateActions(request.Dataset, page.Body)
+		if err == nil && len(actions) > 0 {
+			err = scraper.Repository.SaveCorporateActions(actions)
+		}
+		return len(actions), err
+	default:
+		return 0, fmt.Errorf("unknown dataset %q", request.Dataset)
+	}
+}
+
+// parseForeignFlows reads foreign buying and selling from a JSON trading
+// summary. The rendered summary table has no foreign columns, so HTML
+// pages give no flows.
+func parseForeignFlows(page Page, date time.Time) ([]models.ForeignFlow, error) {
+	if page.Format != PageJSON {
+		return nil, nil
+	}
+
+	var response summaryResponse
+	if err := json.Unmarshal(page.Body, &response); err != nil {
+		return nil, err
+	}
+
+	day := calendar.Day(date)
+	var flows []models.ForeignFlow
+	for _, row := range response.Data {
+		code := strings.TrimSpace(row.StockCode)
+		if code == "" {
+			continue
+		}
+
+		flows = append(flows, models.ForeignFlow{
+			Date:       day,
+			Code:       code,
+			BuyVolume:  int64(row.ForeignBuy),
+			SellVolume: int64(row.ForeignSell),
+		})
+	}
+
+	return flows, nil
+}
+
+type brokerSummaryResponse struct {
+	Data []struct {
+		IDFirm    string  `json:"IDFirm"`
+		FirmName  string  `json:"FirmName"`
+		Volume    float64 `json:"Volume"`
+		Value     float64 `json:"Value"`
+		Frequency float64 `json:"Frequency"`
+	} `json:"data"`
+}
+
+func parseBrokerSummary(body []byte, date time.Time) ([]models.BrokerSummary, error) {
+	var response brokerSummaryResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	var summaries []models.BrokerSummary
+	for _, row := range response.Data {
+		code := strings.TrimSpace(row.IDFirm)
+		if code == "" {
+			continue
+		}
+
+		summaries = append(summaries, models.BrokerSummary{
+			Date:       date,
+			BrokerCode: code,
+			BrokerName: strings.TrimSpace(row.FirmName),
+			Volume:     int64(row.Volume),
+			Value:      int64(row.Value),
+			Frequency:  int64(row.Frequency),
+		})
+	}
+
+	if len(summaries) == 0 {
+		return nil, errors.New("no brokers in the summary")
+	}
+
+	return summaries, nil
+}
+
+type indexConstituentResponse struct {
+	Data []struct {
+		Code   string  `json:"Code"`
+		Name   string  `json:"Name"`
+		Weight float64 `json:"Weight"`
+	} `json:"data"`
+}
+
+func parseIndexConstituents(body []byte, date time.Time, index string) ([]models.IndexConstituent, error) {
+	var response indexConstituentResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	var constituents []models.IndexConstituent
+	for _, row := range response.Data {
+		code := strings.TrimSpace(row.Code)
+		if code == "" {
+			continue
+		}
+
+		constituents = append(constituents, models.IndexConstituent{
+			Date:   date,
+			Index:  index,
+			Code:   code,
+			Name:   strings.TrimSpace(row.Name),
+			Weight: row.Weight,
+		})
+	}
+
+	if len(constituents) == 0 {
+		return nil, fmt.Errorf("no constituents for %s", index)
+	}
+
+	return constituents, nil
+}
+
+// corporateActionResponse covers the dividend, stock split and rights issue
+// lists; each fills the fields that apply to it. Ratios are given as the
+// old and new number of shares, so a 1:5 split is OldRatio 1, NewRatio 5.
+type corporateActionResponse struct {
+	Data []struct {
+		Code          string  `json:"Code"`
+		CashDividend  float64 `json:"CashDividend"`
+		OldRatio      float64 `json:"OldRatio"`
+		NewRatio      float64 `json:"NewRatio"`
+		ExercisePrice float64 `json:"ExercisePrice"`
+		ExDate        string  `json:"ExDate"`
+		RecordingDate string  `json:"RecordingDate"`
+		PaymentDate   string  `json:"PaymentDate"`
+		Note          string  `json:"Note"`
+	} `json:"data"`
+}
+
+// parseCorporateActions reads a dividend, stock split or rights issue list.
+// An empty list is not an error: most days have no new actions.
+func parseCorporateActions(dataset Dataset, body []byte) ([]models.CorporateAction, error) {
+	var response corporateActionResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	var actions []models.CorporateAction
+	for i, row := range response.Data {
+		action := models.CorporateAction{
+			Code:        strings.TrimSpace(row.Code),
+			Description: strings.TrimSpace(row.Note),
+		}
+
+		var err error
+		if action.ExDate, err = parseIDXDate(row.ExDate); err == nil && action.ExDate.IsZero() {
+			err = errors.New("missing ex-date")
+		}
+		if err == nil {
+			action.RecordDate, err = parseIDXDate(row.RecordingDate)
+		}
+		if err == nil {
+			action.PaymentDate, err = parseIDXDate(row.PaymentDate)
+		}
+		if err == nil && action.Code == "" {
+			err = errors.New("missing code")
+		}
+
+		if err == nil {
+			switch dataset {
+			case DatasetDividends:
+				action.Type = models.CorporateActionDividend
+				action.Amount = row.CashDividend
+				if action.Amount <= 0 {
+					err = fmt.Errorf("dividend must be positive, got %v", action.Amount)
+				}
+			case DatasetStockSplits, DatasetRightsIssues:
+				if row.OldRatio <= 0 || row.NewRatio <= 0 {
+					err = fmt.Errorf("invalid ratio %v:%v", row.OldRatio, row.NewRatio)
+					break
+				}
+
+				action.Ratio = row.NewRatio / row.OldRatio
+				action.Price = row.ExercisePrice
+				switch {
+				case dataset == DatasetRightsIssues:
+					action.Type = models.CorporateActionRightsIssue
+				case action.Ratio < 1:
+					action.Type = models.CorporateActionReverseSplit
+				default:
+					action.Type = models.CorporateActionSplit
+				}
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// parseIDXDate reads the dates in IDX responses, which come as
+// 2006-01-02T15:04:05 or plain 2006-01-02, as WIB days. Empty dates are
+// zero.
+func parseIDXDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	day, _, _ := strings.Cut(value, "T")
+	return repository.ParseSnapshotDate(day)
+}
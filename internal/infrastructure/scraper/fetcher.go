@@ -0,0 +1,89 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type PageFormat string
+
+const (
+	PageHTML PageFormat = "html"
+	PageJSON PageFormat = "json"
+)
+
+// Page is a raw response from IDX, parsed by the scraper according to its
+// format.
+type Page struct {
+	Format PageFormat
+	Body   []byte
+}
+
+// Fetcher retrieves the two IDX pages a daily snapshot is built from: the
+// list of listed stocks, which has their names, and the trading summary,
+// which has the day's prices. FetchDataset retrieves the other datasets
+// scraped with it.
+type Fetcher interface {
+	FetchStockList(ctx context.Context) (Page, error)
+	FetchSummary(ctx context.Context, date time.Time) (Page, error)
+	FetchDataset(ctx context.Context, request DatasetRequest) (Page, error)
+	Close() error
+}
+
+// FixtureFetcher serves pages saved in a directory, so the scrape pipeline
+// can run offline. It reads stock_list.json or stock_list.html, and
+// summary_YYYY-MM-DD.json or .html, falling back to summary.json or
+// summary.html. Datasets are read the same way, as <dataset>_YYYY-MM-DD or
+// <dataset>, with the index code after the dataset for index constituents
+// (index_constituents_LQ45.json).
+type FixtureFetcher struct {
+	Dir string
+}
+
+func NewFixtureFetcher(dir string) *FixtureFetcher {
+	return &FixtureFetcher{Dir: dir}
+}
+
+func (fetcher *FixtureFetcher) FetchStockList(ctx context.Context) (Page, error) {
+	return fetcher.read("stock_list")
+}
+
+func (fetcher *FixtureFetcher) FetchSummary(ctx context.Context, date time.Time) (Page, error) {
+	return fetcher.readDated("summary", date)
+}
+
+func (fetcher *FixtureFetcher) FetchDataset(ctx context.Context, request DatasetRequest) (Page, error) {
+	return fetcher.readDated(request.name(), request.Date)
+}
+
+func (fetcher *FixtureFetcher) readDated(name string, date time.Time) (Page, error) {
+	page, err := fetcher.read(name + "_" + date.Format("2006-01-02"))
+	if errors.Is(err, os.ErrNotExist) {
+		return fetcher.read(name)
+	}
+	return page, err
+}
+
+func (fetcher *FixtureFetcher) Close() error {
+	return nil
+}
+
+func (fetcher *FixtureFetcher) read(name string) (Page, error) {
+	for _, format := range []PageFormat{PageJSON, PageHTML} {
+		body, err := os.ReadFile(filepath.Join(fetcher.Dir, name+"."+string(format)))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return Page{}, err
+		}
+
+		return Page{Format: format, Body: body}, nil
+	}
+
+	return Page{}, fmt.Errorf("no %s fixture in %s: %w", name, fetcher.Dir, os.ErrNotExist)
+}
@@ -0,0 +1,146 @@
+package scraper
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// historyLength is how many runs are kept in memory and reported.
+const historyLength = 50
+
+// RunRecord is one scheduled scrape, as kept in the run history.
+type RunRecord struct {
+	// Day is the trading day scraped, as YYYY-MM-DD.
+	Day         string    `json:"day"`
+	Attempt     int       `json:"attempt"`
+	Started     time.Time `json:"started"`
+	Finished    time.Time `json:"finished"`
+	Listed      int       `json:"listed"`
+	Summarised  int       `json:"summarised"`
+	Usable      int       `json:"usable"`
+	Unmatched   []string  `json:"unmatched,omitempty"`
+	Unlisted    []string  `json:"unlisted,omitempty"`
+	ParseErrors []string  `json:"parse_errors,omitempty"`
+	Fetches     int       `json:"fetches"`
+	Error       string    `json:"error,omitempty"`
+	// Datasets are the rows saved per dataset; DatasetErrors do not fail
+	// the run.
+	Datasets      map[string]int `json:"datasets,omitempty"`
+	DatasetErrors []string       `json:"dataset_errors,omitempty"`
+	// Notified is set when the market engine was told to reload;
+	// NotifyError holds why that failed. A failed notification does not
+	// fail the run.
+	Notified    bool   `json:"notified,omitempty"`
+	NotifyError string `json:"notify_error,omitempty"`
+}
+
+func (record RunRecord) Succeeded() bool {
+	return record.Error == ""
+}
+
+// History is the run history, appended to a JSON Lines file so it survives
+// restarts. It is safe for concurrent use.
+type History struct {
+	path string
+
+	mu   sync.Mutex
+	runs []RunRecord
+}
+
+// OpenHistory reads the most recent runs from the file, which need not
+// exist yet.
+func OpenHistory(path string) (*History, error) {
+	history := &History{path: path}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16<<20)
+	for line := 1; scanner.Scan(); line++ {
+		var record RunRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+		}
+		history.keep(record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// Append records a run and writes it to the file.
+func (history *History) Append(record RunRecord) error {
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	history.keep(record)
+
+	if err := os.MkdirAll(filepath.Dir(history.path), os.ModePerm); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(history.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func (history *History) keep(record RunRecord) {
+	history.runs = append(history.runs, record)
+	if len(history.runs) > historyLength {
+		history.runs = history.runs[len(history.runs)-historyLength:]
+	}
+}
+
+// Runs returns the recent runs, oldest first.
+func (history *History) Runs() []RunRecord {
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	return append([]RunRecord(nil), history.runs...)
+}
+
+// Day returns the runs for one trading day, oldest first.
+func (history *History) Day(day string) []RunRecord {
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	var runs []RunRecord
+	for _, run := range history.runs {
+		if run.Day == day {
+			runs = append(runs, run)
+		}
+	}
+
+	return runs
+}
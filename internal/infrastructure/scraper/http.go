@@ -0,0 +1,101 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	stockListPath = "/primary/StockData/GetSecuritiesStock"
+	summaryPath   = "/primary/TradingSummary/GetStockSummary"
+)
+
+// StatusError is returned when IDX answers with anything but 200 OK.
+type StatusError struct {
+	Path   string
+	Code   int
+	Status string
+}
+
+func (err *StatusError) Error() string {
+	return fmt.Sprintf("GET %s: %s", err.Path, err.Status)
+}
+
+// HTTPFetcher calls the JSON endpoints behind the IDX pages directly, with
+// no browser.
+type HTTPFetcher struct {
+	Client  *http.Client
+	BaseURL string
+}
+
+func NewHTTPFetcher() *HTTPFetcher {
+	return &HTTPFetcher{
+		Client:  &http.Client{Timeout: 30 * time.Second},
+		BaseURL: idxBaseURL,
+	}
+}
+
+func (fetcher *HTTPFetcher) FetchStockList(ctx context.Context) (Page, error) {
+	return fetcher.get(ctx, stockListPath, url.Values{
+		"start":    {"0"},
+		"length":   {"9999"},
+		"language": {"id-id"},
+	}, stockListPageURL)
+}
+
+func (fetcher *HTTPFetcher) FetchSummary(ctx context.Context, date time.Time) (Page, error) {
+	return fetcher.get(ctx, summaryPath, url.Values{
+		"start":  {"0"},
+		"length": {"9999"},
+		"date":   {date.Format("20060102")},
+	}, summaryPageURL)
+}
+
+func (fetcher *HTTPFetcher) FetchDataset(ctx context.Context, request DatasetRequest) (Page, error) {
+	path, query, referer, err := datasetEndpoint(request)
+	if err != nil {
+		return Page{}, err
+	}
+
+	return fetcher.get(ctx, path, query, referer)
+}
+
+func (fetcher *HTTPFetcher) Close() error {
+	fetcher.Client.CloseIdleConnections()
+	return nil
+}
+
+func (fetcher *HTTPFetcher) get(ctx context.Context, path string, query url.Values, referer string) (Page, error) {
+	endpoint := fetcher.BaseURL + path + "?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Page{}, err
+	}
+
+	// IDX rejects requests that do not look like they come from its pages.
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Referer", referer)
+
+	res, err := fetcher.Client.Do(req)
+	if err != nil {
+		return Page{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Page{}, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return Page{}, &StatusError{Path: path, Code: res.StatusCode, Status: res.Status}
+	}
+
+	return Page{Format: PageJSON, Body: body}, nil
+}
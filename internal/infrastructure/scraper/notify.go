@@ -0,0 +1,67 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	marketv1 "market-engine-go/gen/go/market/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// EngineNotifier asks a running market engine to reload its reference
+// prices from the new snapshot. The engine must read the same snapshots
+// the scraper writes. An engine that requires credentials needs APIKey or
+// TokenFile, for a caller with the admin role.
+type EngineNotifier struct {
+	Address string
+	// APIKey is sent in the x-api-key header.
+	APIKey string
+	// TokenFile holds a JWT sent as a bearer token. It is read on every
+	// reload, so a token can be replaced before it expires.
+	TokenFile string
+}
+
+func (notifier EngineNotifier) Notify(ctx context.Context, day time.Time) error {
+	conn, err := grpc.NewClient(notifier.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, err = notifier.withCredentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := marketv1.NewAdminServiceClient(conn).ReloadReferenceData(ctx, &marketv1.ReloadReferenceDataRequest{
+		Date: day.Format(dayLayout),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[Daemon] Market engine reloaded %d symbols from the %s snapshot", res.GetSymbolCount(), res.GetDate())
+	return nil
+}
+
+func (notifier EngineNotifier) withCredentials(ctx context.Context) (context.Context, error) {
+	switch {
+	case notifier.APIKey != "":
+		return metadata.AppendToOutgoingContext(ctx, "x-api-key", notifier.APIKey), nil
+	case notifier.TokenFile != "":
+		token, err := os.ReadFile(notifier.TokenFile)
+		if err != nil {
+			return ctx, fmt.Errorf("engine token: %w", err)
+		}
+		return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+strings.TrimSpace(string(token))), nil
+	default:
+		return ctx, nil
+	}
+}
@@ -0,0 +1,77 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy is how often a failed fetch is tried again. The wait doubles
+// after every failure, up to MaxBackoff.
+type RetryPolicy struct {
+	// Attempts is the total number of tries; zero or one never retries.
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Attempts:       4,
+		InitialBackoff: 2 * time.Second,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+// retry calls attempt until it succeeds, fails permanently, runs out of
+// attempts or ctx ends, and returns the number of tries made.
+func (policy RetryPolicy) retry(ctx context.Context, what string, attempt func() error) (int, error) {
+	backoff := policy.InitialBackoff
+	for tries := 1; ; tries++ {
+		err := attempt()
+		if err == nil {
+			return tries, nil
+		}
+
+		if tries >= policy.Attempts || !transient(ctx, err) {
+			return tries, err
+		}
+
+		log.Printf("[Scraper] %s failed (attempt %d of %d), retrying in %v: %v", what, tries, policy.Attempts, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return tries, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
+
+// transient reports whether a failure may go away on its own. Network
+// errors, server errors, rate limiting and pages that did not parse (a
+// half-rendered page or a bot challenge) are retried; cancellation, other
+// client errors and missing fixture files are not.
+func transient(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, fs.ErrNotExist) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code >= http.StatusInternalServerError || statusErr.Code == http.StatusTooManyRequests
+	}
+
+	return true
+}
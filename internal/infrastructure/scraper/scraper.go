@@ -1,167 +1,383 @@
 package scraper
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"market-engine-go/internal/infrastructure/repository"
 	"market-engine-go/internal/models"
-	"os/exec"
+	"market-engine-go/internal/utils"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
-	"github.com/tebeka/selenium"
-	"github.com/tebeka/selenium/chrome"
 )
 
-func GetIdxTodaySnapshot() {
-	shellPath := "./bin/chrome-headless-shell-mac-arm64/chrome-headless-shell"
-	caps := selenium.Capabilities{"browserName": "chrome"}
-	chromeCaps := chrome.Capabilities{
-		Path: shellPath,
-		Args: []string{
-			"--headless",
-			"--user-agent=Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/96.0.4664.110 Safari/537.36",
-		},
+// ErrDegenerateSnapshot is returned when a scrape produced too little data
+// to be trusted, so it was not saved over the existing snapshots.
+var ErrDegenerateSnapshot = errors.New("degenerate snapshot")
+
+// Scraper builds the daily snapshot: it fetches the stock list and the
+// trading summary, parses both, joins the names onto the prices and saves
+// the result.
+type Scraper struct {
+	Fetcher    Fetcher
+	Repository repository.StockRepository
+	Retry      RetryPolicy
+	// MinShare is the smallest share of the latest saved snapshot's stock
+	// count a new snapshot needs to be saved. A sudden drop is far more
+	// likely a broken page than a market event; zero disables the check.
+	MinShare float64
+	// Datasets are scraped after the snapshot is saved, constituents for
+	// each of Indices. Foreign flows are always saved when the summary has
+	// them.
+	Datasets []Dataset
+	Indices  []string
+}
+
+func NewScraper(fetcher Fetcher, repo repository.StockRepository) *Scraper {
+	return &Scraper{
+		Fetcher:    fetcher,
+		Repository: repo,
+		Retry:      DefaultRetryPolicy(),
+		MinShare:   0.5,
+		Datasets:   DefaultDatasets(),
+		Indices:    DefaultIndices(),
 	}
+}
 
-	caps.AddChrome(chromeCaps)
+// Result describes what a scrape found and saved.
+type Result struct {
+	Date time.Time
+	// Listed is the number of stocks in the stock list and Summarised the
+	// number of rows in the trading summary.
+	Listed     int
+	Summarised int
+	// Usable is the number of rows with usable prices, which is what gets
+	// saved.
+	Usable int
+	// Unmatched are listed codes with no summary row; they are not saved.
+	Unmatched []string
+	// Unlisted are summary codes missing from the stock list, such as new
+	// listings; they are saved with the name from the summary, if any.
+	Unlisted []string
+	// ParseErrors are rows that were skipped, with the reason.
+	ParseErrors []string
+	// Attempts counts page fetches, including retries.
+	Attempts int
+	// Datasets is the number of rows saved per dataset, such as
+	// foreign_flows or index_constituents_LQ45, and DatasetErrors the
+	// datasets that could not be scraped.
+	Datasets      map[string]int
+	DatasetErrors []string
+}
+
+func (result Result) String() string {
+	return fmt.Sprintf("%s: %d listed, %d in the summary, %d usable, %d unmatched, %d unlisted, %d parse errors, %d fetches",
+		result.Date.Format("2006-01-02"), result.Listed, result.Summarised, result.Usable,
+		len(result.Unmatched), len(result.Unlisted), len(result.ParseErrors), result.Attempts)
+}
 
-	path, err := exec.LookPath("chromedriver")
+// Run scrapes the snapshot for the given trading day and saves it. The
+// result is filled in as far as the scrape got, also when it fails.
+func (scraper *Scraper) Run(ctx context.Context, date time.Time) (Result, error) {
+	result := Result{Date: date}
+
+	stocks, err := scraper.fetch(ctx, &result, "stock list", scraper.Fetcher.FetchStockList, parseStockList)
 	if err != nil {
-		log.Fatal("Could not find chromedriver")
+		return result, err
+	}
+	result.Listed = len(stocks)
+
+	var summaryPage Page
+	fetchSummary := func(ctx context.Context) (Page, error) {
+		page, err := scraper.Fetcher.FetchSummary(ctx, date)
+		summaryPage = page
+		return page, err
 	}
 
-	service, err := selenium.NewChromeDriverService(path, 4444)
+	snapshots, err := scraper.fetch(ctx, &result, "trading summary", fetchSummary, parseSummary)
 	if err != nil {
-		log.Fatalf("Failed to start Selenium service: %v", err)
+		return result, err
 	}
-	defer service.Stop()
+	result.Summarised = len(snapshots)
 
-	driver, err := selenium.NewRemote(caps, "")
+	joined := joinStockLists(stocks, snapshots, &result)
+	log.Printf("[Scraper] %v", result)
 
-	if err != nil {
-		log.Fatal("Error:", err)
+	if err := scraper.check(result); err != nil {
+		return result, err
 	}
 
-	stockList := "https://www.idx.co.id/id/data-pasar/data-saham/daftar-saham"
-	err = driver.Get(stockList)
-	if err != nil {
-		log.Fatal("Error:", err)
+	if err := scraper.Repository.SaveAll(date, joined); err != nil {
+		return result, fmt.Errorf("saving snapshot: %w", err)
 	}
 
-	html, err := driver.PageSource()
+	scraper.scrapeDatasets(ctx, date, summaryPage, &result)
+
+	return result, nil
+}
+
+// fetch fetches and parses one page, retrying both: a page that does not
+// parse is usually one that did not finish rendering.
+func (scraper *Scraper) fetch(ctx context.Context, result *Result, what string,
+	fetch func(context.Context) (Page, error),
+	parse func(Page) ([]models.Stock, []string, error)) ([]models.Stock, error) {
+	var stocks []models.Stock
+	var problems []string
+
+	tries, err := scraper.Retry.retry(ctx, what, func() error {
+		page, err := fetch(ctx)
+		if err != nil {
+			return fmt.Errorf("fetching %s: %w", what, err)
+		}
+
+		stocks, problems, err = parse(page)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", what, err)
+		}
+
+		return nil
+	})
+
+	result.Attempts += tries
+	for _, problem := range problems {
+		result.ParseErrors = append(result.ParseErrors, what+": "+problem)
+	}
+
+	return stocks, err
+}
+
+// check refuses snapshots that would replace good data with a broken one.
+func (scraper *Scraper) check(result Result) error {
+	if result.Usable == 0 {
+		return fmt.Errorf("%w: no stock has usable prices", ErrDegenerateSnapshot)
+	}
+
+	if scraper.MinShare <= 0 {
+		return nil
+	}
+
+	latest, previous, err := scraper.Repository.Load(time.Time{})
+	if errors.Is(err, repository.ErrNoSnapshot) {
+		return nil
+	}
 	if err != nil {
-		log.Fatal("Error:", err)
+		return fmt.Errorf("loading the latest snapshot: %w", err)
 	}
 
-	stocks := parseStocksData(html)
+	if float64(result.Usable) < scraper.MinShare*float64(len(previous)) {
+		return fmt.Errorf("%w: %d stocks, the snapshot for %s has %d",
+			ErrDegenerateSnapshot, result.Usable, latest.Format("2006-01-02"), len(previous))
+	}
 
-	todaysSnapshotUrl := "https://www.idx.co.id/id/data-pasar/ringkasan-perdagangan/ringkasan-saham"
+	return nil
+}
 
-	err = driver.Get(todaysSnapshotUrl)
+func parseStockList(page Page) ([]models.Stock, []string, error) {
+	var stocks []models.Stock
+	var err error
+
+	switch page.Format {
+	case PageHTML:
+		stocks, err = parseStocksData(string(page.Body))
+	case PageJSON:
+		stocks, err = parseStockListJSON(page.Body)
+	default:
+		err = fmt.Errorf("unknown page format %q", page.Format)
+	}
 	if err != nil {
-		log.Fatal("Error:", err)
+		return nil, nil, err
 	}
 
-	err = driver.WaitWithTimeout(func(wd selenium.WebDriver) (bool, error) {
-		selector := "select[id^='vgt-select-rpp-']"
-		dropdownTrigger, err := driver.FindElement(selenium.ByCSSSelector, selector)
-		if err == nil {
-			dropdownTrigger.Click()
-			return true, nil
-		}
-		return false, nil
+	stocks, problems := checkCodes(stocks)
+	if len(stocks) == 0 {
+		return nil, problems, errors.New("no stocks in the list")
+	}
 
-	}, 3*time.Second)
+	return stocks, problems, nil
+}
 
+func parseSummary(page Page) ([]models.Stock, []string, error) {
+	var stocks []models.Stock
+	var problems []string
+	var err error
+
+	switch page.Format {
+	case PageHTML:
+		stocks, problems, err = ParseTableToStocks(string(page.Body))
+	case PageJSON:
+		stocks, err = parseSummaryJSON(page.Body)
+	default:
+		err = fmt.Errorf("unknown page format %q", page.Format)
+	}
 	if err != nil {
-		log.Fatal("Could not find or click the dropdown button: ", err)
+		return nil, nil, err
 	}
 
-	err = driver.WaitWithTimeout(func(wd selenium.WebDriver) (bool, error) {
-		allOption, err := wd.FindElement(selenium.ByCSSSelector, "option[value='-1']")
-		if err == nil {
-			allOption.Click()
-			return true, nil
+	stocks, codeProblems := checkCodes(stocks)
+	problems = append(problems, codeProblems...)
+	if len(stocks) == 0 {
+		return nil, problems, errors.New("no rows in the summary")
+	}
+
+	return stocks, problems, nil
+}
+
+// checkCodes drops rows without a code and repeats of a code.
+func checkCodes(stocks []models.Stock) ([]models.Stock, []string) {
+	var problems []string
+	seen := make(map[string]bool, len(stocks))
+
+	kept := stocks[:0]
+	for i, stock := range stocks {
+		switch {
+		case stock.Code == "":
+			problems = append(problems, fmt.Sprintf("row %d has no code", i+1))
+		case seen[stock.Code]:
+			problems = append(problems, fmt.Sprintf("row %d repeats %s", i+1, stock.Code))
+		default:
+			seen[stock.Code] = true
+			kept = append(kept, stock)
 		}
-		return false, nil
-	}, 3*time.Second)
+	}
 
-	if err != nil {
-		log.Fatal("Could not find or click the 'All' option:", err)
+	return kept, problems
+}
+
+type stockListResponse struct {
+	Data []struct {
+		Code string `json:"Code"`
+		Name string `json:"Name"`
+	} `json:"data"`
+}
+
+func parseStockListJSON(body []byte) ([]models.Stock, error) {
+	var response stockListResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
 	}
 
-	time.Sleep(3 * time.Second)
+	stocks := make([]models.Stock, 0, len(response.Data))
+	for _, row := range response.Data {
+		stocks = append(stocks, models.Stock{Code: strings.TrimSpace(row.Code), Name: strings.TrimSpace(row.Name)})
+	}
 
-	snapshotHtml, err := driver.PageSource()
-	if err != nil {
-		log.Fatal("Error:", err)
+	return stocks, nil
+}
+
+type summaryResponse struct {
+	Data []struct {
+		StockCode string  `json:"StockCode"`
+		StockName string  `json:"StockName"`
+		High      float64 `json:"High"`
+		Low       float64 `json:"Low"`
+		Close     float64 `json:"Close"`
+		Change    float64 `json:"Change"`
+		Volume    float64 `json:"Volume"`
+		Value     float64 `json:"Value"`
+		Frequency float64 `json:"Frequency"`
+		// ForeignBuy and ForeignSell are shares bought and sold by foreign
+		// investors.
+		ForeignBuy  float64 `json:"ForeignBuy"`
+		ForeignSell float64 `json:"ForeignSell"`
+	} `json:"data"`
+}
+
+// parseSummaryJSON writes the numbers in the IDX locale, as the summary
+// table shows them, so snapshots look the same whichever fetcher made them.
+func parseSummaryJSON(body []byte) ([]models.Stock, error) {
+	var response summaryResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	format := func(value float64) string {
+		return utils.FormatNumber(value, utils.NumberFormatIDX)
 	}
 
-	snapshots, err := ParseTableToStocks(snapshotHtml)
+	stocks := make([]models.Stock, 0, len(response.Data))
+	for _, row := range response.Data {
+		stocks = append(stocks, models.Stock{
+			Code:      strings.TrimSpace(row.StockCode),
+			Name:      strings.TrimSpace(row.StockName),
+			High:      format(row.High),
+			Low:       format(row.Low),
+			Close:     format(row.Close),
+			Change:    format(row.Change),
+			Volume:    format(row.Volume),
+			Value:     format(row.Value),
+			Frequency: format(row.Frequency),
+		})
+	}
 
-	joined := joinStockLists(stocks, snapshots)
-	repo := repository.NewCsvStockRepository("./output")
-	repo.SaveAll(joined)
+	return stocks, nil
 }
 
-func ParseTableToStocks(html string) ([]models.Stock, error) {
+// ParseTableToStocks reads the rendered trading summary table. Rows with too
+// few columns are skipped and described in the returned problems.
+func ParseTableToStocks(html string) ([]models.Stock, []string, error) {
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var stocks []models.Stock
+	var problems []string
 
 	// Find the table rows.
 	// Usually tables on IDX have a 'tbody' and 'tr' tags.
 	selection := doc.Find("#vgt-table")
+	if selection.Length() == 0 {
+		return nil, nil, errors.New("summary table not found")
+	}
+
 	selection.Find("tbody tr").Each(func(i int, s *goquery.Selection) {
 		// Extract each column (td)
 		cols := s.Find("td")
 
 		// 0: Kode Saham, 1: Tertinggi (High), 2: Terendah (Low),
 		// 3: Penutupan (Close), 4: Selisih (Change), 5: Volume, 6: Nilai (Value), 7: Frekuensi
-		if cols.Length() >= 8 {
-			stock := models.Stock{
-				Code:      strings.TrimSpace(cols.Eq(0).Text()),
-				High:      strings.TrimSpace(cols.Eq(1).Text()),
-				Low:       strings.TrimSpace(cols.Eq(2).Text()),
-				Close:     strings.TrimSpace(cols.Eq(3).Text()),
-				Change:    cleanNumber(strings.TrimSpace(cols.Eq(4).Text())),
-				Volume:    strings.TrimSpace(cols.Eq(5).Text()),
-				Value:     strings.TrimSpace(cols.Eq(6).Text()),
-				Frequency: strings.TrimSpace(cols.Eq(7).Text()),
-			}
-			stocks = append(stocks, stock)
+		if cols.Length() < 8 {
+			problems = append(problems, fmt.Sprintf("row %d has %d columns, want 8", i+1, cols.Length()))
+			return
 		}
+
+		stock := models.Stock{
+			Code:      strings.TrimSpace(cols.Eq(0).Text()),
+			High:      strings.TrimSpace(cols.Eq(1).Text()),
+			Low:       strings.TrimSpace(cols.Eq(2).Text()),
+			Close:     strings.TrimSpace(cols.Eq(3).Text()),
+			Change:    cleanNumber(strings.TrimSpace(cols.Eq(4).Text())),
+			Volume:    strings.TrimSpace(cols.Eq(5).Text()),
+			Value:     strings.TrimSpace(cols.Eq(6).Text()),
+			Frequency: strings.TrimSpace(cols.Eq(7).Text()),
+		}
+		stocks = append(stocks, stock)
 	})
 
-	return stocks, nil
+	return stocks, problems, nil
 }
 
-func parseStocksData(html string) []models.Stock {
+func parseStocksData(html string) ([]models.Stock, error) {
 	reRowData := regexp.MustCompile(`(?s)rowData:(\[.*?\])`)
 	match := reRowData.FindStringSubmatch(html)
 	if len(match) < 2 {
-		fmt.Println("No rowData found")
-		return nil
+		return nil, errors.New("no rowData found in the page")
 	}
 	rawRows := match[1]
 
 	finalJson := aggressiveClean(rawRows)
 
 	var stocks []models.Stock
-	err := json.Unmarshal([]byte(finalJson), &stocks)
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return nil
+	if err := json.Unmarshal([]byte(finalJson), &stocks); err != nil {
+		return nil, fmt.Errorf("decoding rowData: %w", err)
 	}
 
-	return stocks
+	return stocks, nil
 }
 
 func aggressiveClean(raw string) string {
@@ -179,26 +395,52 @@ func aggressiveClean(raw string) string {
 	return res
 }
 
-func joinStockLists(targets []models.Stock, sources []models.Stock) []models.Stock {
+// joinStockLists puts the names from the stock list on the summary rows
+// and records in result which codes did not match and which rows have no
+// usable prices. Only rows with usable prices are returned.
+func joinStockLists(targets []models.Stock, sources []models.Stock, result *Result) []models.Stock {
 	sourceMap := make(map[string]models.Stock)
 	for _, s := range sources {
 		sourceMap[s.Code] = s
 	}
 
 	joinedList := make([]models.Stock, 0, len(targets))
+	keep := func(stock models.Stock) {
+		if _, err := repository.ParseStock(stock, utils.NumberFormatIDX); err != nil {
+			result.ParseErrors = append(result.ParseErrors, fmt.Sprintf("%s: %v", stock.Code, err))
+			return
+		}
+		joinedList = append(joinedList, stock)
+	}
+
+	listed := make(map[string]bool, len(targets))
 	for _, t := range targets {
-		if data, found := sourceMap[t.Code]; found {
-			t.High = data.High
-			t.Low = data.Low
-			t.Close = data.Close
-			t.Change = data.Change
-			t.Volume = data.Volume
-			t.Value = data.Value
-			t.Frequency = data.Frequency
+		listed[t.Code] = true
+
+		data, found := sourceMap[t.Code]
+		if !found {
+			result.Unmatched = append(result.Unmatched, t.Code)
+			continue
+		}
+
+		t.High = data.High
+		t.Low = data.Low
+		t.Close = data.Close
+		t.Change = data.Change
+		t.Volume = data.Volume
+		t.Value = data.Value
+		t.Frequency = data.Frequency
+		keep(t)
+	}
+
+	for _, s := range sources {
+		if !listed[s.Code] {
+			result.Unlisted = append(result.Unlisted, s.Code)
+			keep(s)
 		}
-		joinedList = append(joinedList, t)
 	}
 
+	result.Usable = len(joinedList)
 	return joinedList
 }
 
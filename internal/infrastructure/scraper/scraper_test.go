@@ -0,0 +1,157 @@
+package scraper
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"market-engine-go/internal/infrastructure/calendar"
+	"market-engine-go/internal/infrastructure/repository"
+	"market-engine-go/internal/models"
+	"market-engine-go/internal/utils"
+)
+
+var fixtureDay = time.Date(2025, 12, 22, 0, 0, 0, 0, calendar.Jakarta)
+
+// TestScrapeJSONFixtures runs the pipeline on the JSON pages the HTTP
+// fetcher gets: the stock list, the trading summary and every dataset.
+func TestScrapeJSONFixtures(t *testing.T) {
+	store := repository.NewSnapshotStore(t.TempDir(), utils.NumberFormatIDX)
+	scrape := NewScraper(NewFixtureFetcher("testdata"), store)
+	scrape.Indices = []string{"LQ45"}
+
+	result, err := scrape.Run(context.Background(), fixtureDay)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if result.Listed != 4 || result.Summarised != 3 || result.Usable != 3 {
+		t.Errorf("got %d listed, %d summarised, %d usable, want 4, 3, 3", result.Listed, result.Summarised, result.Usable)
+	}
+	if !slices.Equal(result.Unmatched, []string{"XNEW"}) {
+		t.Errorf("unmatched = %v, want [XNEW]", result.Unmatched)
+	}
+	if len(result.Unlisted) != 0 || len(result.ParseErrors) != 0 || len(result.DatasetErrors) != 0 {
+		t.Errorf("unlisted %v, parse errors %v, dataset errors %v, want none", result.Unlisted, result.ParseErrors, result.DatasetErrors)
+	}
+
+	wantDatasets := map[string]int{
+		"foreign_flows":           3,
+		"broker_summary":          3,
+		"index_constituents_LQ45": 3,
+		"dividends":               1,
+		"stock_splits":            1,
+		"rights_issues":           0,
+	}
+	for name, want := range wantDatasets {
+		if got, ok := result.Datasets[name]; !ok || got != want {
+			t.Errorf("datasets[%s] = %d (saved %t), want %d", name, got, ok, want)
+		}
+	}
+	// Two pages for the snapshot and one per dataset, none retried.
+	if result.Attempts != 2+len(wantDatasets)-1 {
+		t.Errorf("attempts = %d, want %d", result.Attempts, 2+len(wantDatasets)-1)
+	}
+
+	checkSnapshot(t, store, []models.DailyBar{
+		{Code: "AALI", Name: "Astra Agro Lestari Tbk.", High: 7475, Low: 7300, Close: 7400, Change: 25, Volume: 423100, Value: 3115632500, Frequency: 681},
+		{Code: "BBCA", Name: "Bank Central Asia Tbk.", High: 8175, Low: 8025, Close: 8175, Change: 125, Volume: 72424200, Value: 587861680000, Frequency: 19774},
+		{Code: "GOTO", Name: "GoTo Gojek Tokopedia Tbk.", High: 66, Low: 63, Close: 65, Change: 1, Volume: 2145678900, Value: 138321000000, Frequency: 25410},
+	})
+
+	flows, err := store.ForeignFlows("BBCA", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("ForeignFlows: %v", err)
+	}
+	if len(flows) != 1 || flows[0].BuyVolume != 41250000 || flows[0].SellVolume != 30110000 {
+		t.Errorf("BBCA foreign flows = %+v, want one day of 41250000 bought and 30110000 sold", flows)
+	}
+
+	constituents, err := store.IndexConstituents("LQ45", time.Time{})
+	if err != nil {
+		t.Fatalf("IndexConstituents: %v", err)
+	}
+	if len(constituents) != 3 {
+		t.Errorf("got %d LQ45 constituents, want 3", len(constituents))
+	}
+
+	actions, err := store.CorporateActions("", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("CorporateActions: %v", err)
+	}
+	if len(actions) != 2 {
+		t.Errorf("got %d corporate actions, want a dividend and a split", len(actions))
+	}
+}
+
+// TestScrapeHTMLFixtures runs the pipeline on the rendered pages the
+// Selenium fetcher gets: the stock list's rowData script and the summary
+// table.
+func TestScrapeHTMLFixtures(t *testing.T) {
+	store := repository.NewSnapshotStore(t.TempDir(), utils.NumberFormatIDX)
+	scrape := NewScraper(NewFixtureFetcher(filepath.Join("testdata", "html")), store)
+	scrape.Datasets = nil
+
+	result, err := scrape.Run(context.Background(), fixtureDay)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if result.Listed != 3 || result.Summarised != 4 || result.Usable != 4 {
+		t.Errorf("got %d listed, %d summarised, %d usable, want 3, 4, 4", result.Listed, result.Summarised, result.Usable)
+	}
+	if len(result.Unmatched) != 0 {
+		t.Errorf("unmatched = %v, want none", result.Unmatched)
+	}
+	if !slices.Equal(result.Unlisted, []string{"CDIA"}) {
+		t.Errorf("unlisted = %v, want [CDIA]", result.Unlisted)
+	}
+	// The total row under the table is skipped.
+	if !slices.Equal(result.ParseErrors, []string{"trading summary: row 5 has 1 columns, want 8"}) {
+		t.Errorf("parse errors = %v", result.ParseErrors)
+	}
+	// The rendered table has no foreign columns.
+	if got, ok := result.Datasets["foreign_flows"]; !ok || got != 0 {
+		t.Errorf("datasets[foreign_flows] = %d (saved %t), want 0", got, ok)
+	}
+
+	checkSnapshot(t, store, []models.DailyBar{
+		{Code: "AALI", Name: "Astra Agro Lestari Tbk.", High: 7475, Low: 7300, Close: 7400, Change: 25, Volume: 423100, Value: 3115632500, Frequency: 681},
+		{Code: "BBCA", Name: "Bank Central Asia Tbk.", High: 8175, Low: 8025, Close: 8175, Change: 125, Volume: 72424200, Value: 587861680000, Frequency: 19774},
+		{Code: "GOTO", Name: "GoTo Gojek Tokopedia Tbk.", High: 66, Low: 63, Close: 65, Change: 1, Volume: 2145678900, Value: 138321000000, Frequency: 25410},
+		{Code: "CDIA", High: 1890, Low: 1705, Close: 1850, Change: -1050, Volume: 98250400, Value: 176104300000, Frequency: 41905},
+	})
+}
+
+// checkSnapshot compares the latest saved snapshot with want, which it
+// expects to be saved under the fixture day.
+func checkSnapshot(t *testing.T, store repository.StockRepository, want []models.DailyBar) {
+	t.Helper()
+
+	date, bars, err := store.Load(time.Time{})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !date.Equal(fixtureDay) {
+		t.Errorf("saved under %s, want %s", date.Format(time.DateOnly), fixtureDay.Format(time.DateOnly))
+	}
+
+	got := make(map[string]models.DailyBar, len(bars))
+	for _, bar := range bars {
+		if !bar.Date.Equal(fixtureDay) {
+			t.Errorf("saved %s dated %s, want %s", bar.Code, bar.Date.Format(time.DateOnly), fixtureDay.Format(time.DateOnly))
+		}
+		bar.Date = time.Time{}
+		got[bar.Code] = bar
+	}
+	if len(got) != len(want) {
+		t.Errorf("saved %d rows, want %d", len(got), len(want))
+	}
+	for _, bar := range want {
+		if got[bar.Code] != bar {
+			t.Errorf("saved %s = %+v, want %+v", bar.Code, got[bar.Code], bar)
+		}
+	}
+}
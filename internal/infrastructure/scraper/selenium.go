@@ -0,0 +1,176 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/tebeka/selenium"
+	"github.com/tebeka/selenium/chrome"
+)
+
+const idxBaseURL = "https://www.idx.co.id"
+
+const (
+	stockListPageURL       = idxBaseURL + "/id/data-pasar/data-saham/daftar-saham"
+	summaryPageURL         = idxBaseURL + "/id/data-pasar/ringkasan-perdagangan/ringkasan-saham"
+	brokerSummaryPageURL   = idxBaseURL + "/id/data-pasar/ringkasan-perdagangan/ringkasan-broker"
+	indexPageURL           = idxBaseURL + "/id/data-pasar/data-saham/indeks-saham"
+	corporateActionPageURL = idxBaseURL + "/id/perusahaan-tercatat/aksi-korporasi"
+)
+
+const userAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/96.0.4664.110 Safari/537.36"
+
+type SeleniumOptions struct {
+	// ChromePath is the Chrome or chrome-headless-shell binary; empty lets
+	// chromedriver find an installed Chrome.
+	ChromePath string
+	// ChromeDriverPath is the chromedriver binary; empty looks it up on PATH.
+	ChromeDriverPath string
+	Port             int
+	// WaitTimeout bounds each wait for the summary table controls.
+	WaitTimeout time.Duration
+}
+
+func DefaultSeleniumOptions() SeleniumOptions {
+	return SeleniumOptions{
+		Port:        4444,
+		WaitTimeout: 3 * time.Second,
+	}
+}
+
+// SeleniumFetcher renders the IDX pages in headless Chrome driven by
+// chromedriver and reads the data from the page source.
+type SeleniumFetcher struct {
+	options SeleniumOptions
+	service *selenium.Service
+	driver  selenium.WebDriver
+}
+
+func NewSeleniumFetcher(options SeleniumOptions) (*SeleniumFetcher, error) {
+	driverPath := options.ChromeDriverPath
+	if driverPath == "" {
+		path, err := exec.LookPath("chromedriver")
+		if err != nil {
+			return nil, fmt.Errorf("could not find chromedriver: %w", err)
+		}
+		driverPath = path
+	}
+
+	service, err := selenium.NewChromeDriverService(driverPath, options.Port)
+	if err != nil {
+		return nil, fmt.Errorf("starting chromedriver: %w", err)
+	}
+
+	caps := selenium.Capabilities{"browserName": "chrome"}
+	caps.AddChrome(chrome.Capabilities{
+		Path: options.ChromePath,
+		Args: []string{
+			"--headless",
+			"--user-agent=" + userAgent,
+		},
+	})
+
+	driver, err := selenium.NewRemote(caps, fmt.Sprintf("http://localhost:%d/wd/hub", options.Port))
+	if err != nil {
+		service.Stop()
+		return nil, fmt.Errorf("starting Chrome: %w", err)
+	}
+
+	return &SeleniumFetcher{options: options, service: service, driver: driver}, nil
+}
+
+func (fetcher *SeleniumFetcher) FetchStockList(ctx context.Context) (Page, error) {
+	if err := fetcher.driver.Get(stockListPageURL); err != nil {
+		return Page{}, err
+	}
+
+	html, err := fetcher.driver.PageSource()
+	if err != nil {
+		return Page{}, err
+	}
+
+	return Page{Format: PageHTML, Body: []byte(html)}, nil
+}
+
+// FetchSummary always returns the summary IDX currently shows, which is the
+// latest trading day; the page has no date selector.
+func (fetcher *SeleniumFetcher) FetchSummary(ctx context.Context, date time.Time) (Page, error) {
+	if err := fetcher.driver.Get(summaryPageURL); err != nil {
+		return Page{}, err
+	}
+
+	err := fetcher.driver.WaitWithTimeout(func(wd selenium.WebDriver) (bool, error) {
+		dropdownTrigger, err := wd.FindElement(selenium.ByCSSSelector, "select[id^='vgt-select-rpp-']")
+		if err == nil {
+			dropdownTrigger.Click()
+			return true, nil
+		}
+		return false, nil
+	}, fetcher.options.WaitTimeout)
+	if err != nil {
+		return Page{}, fmt.Errorf("could not find or click the rows-per-page dropdown: %w", err)
+	}
+
+	err = fetcher.driver.WaitWithTimeout(func(wd selenium.WebDriver) (bool, error) {
+		allOption, err := wd.FindElement(selenium.ByCSSSelector, "option[value='-1']")
+		if err == nil {
+			allOption.Click()
+			return true, nil
+		}
+		return false, nil
+	}, fetcher.options.WaitTimeout)
+	if err != nil {
+		return Page{}, fmt.Errorf("could not find or click the 'All' option: %w", err)
+	}
+
+	// The table re-renders with every row after the option is chosen.
+	select {
+	case <-ctx.Done():
+		return Page{}, ctx.Err()
+	case <-time.After(3 * time.Second):
+	}
+
+	html, err := fetcher.driver.PageSource()
+	if err != nil {
+		return Page{}, err
+	}
+
+	return Page{Format: PageHTML, Body: []byte(html)}, nil
+}
+
+// FetchDataset opens the dataset's JSON endpoint in the browser, which gets
+// past the bot checks plain HTTP requests can run into, and reads the JSON
+// Chrome displays.
+func (fetcher *SeleniumFetcher) FetchDataset(ctx context.Context, request DatasetRequest) (Page, error) {
+	path, query, _, err := datasetEndpoint(request)
+	if err != nil {
+		return Page{}, err
+	}
+
+	if err := fetcher.driver.Get(idxBaseURL + path + "?" + query.Encode()); err != nil {
+		return Page{}, err
+	}
+
+	text, err := fetcher.driver.ExecuteScript("return document.body.innerText", nil)
+	if err != nil {
+		return Page{}, err
+	}
+
+	body, ok := text.(string)
+	if !ok {
+		return Page{}, fmt.Errorf("unexpected page text %T", text)
+	}
+
+	return Page{Format: PageJSON, Body: []byte(body)}, nil
+}
+
+func (fetcher *SeleniumFetcher) Close() error {
+	quitErr := fetcher.driver.Quit()
+	stopErr := fetcher.service.Stop()
+	if quitErr != nil {
+		return quitErr
+	}
+	return stopErr
+}
@@ -2,24 +2,174 @@ package models
 
 import "time"
 
+const (
+	SideBuy  = "BUY"
+	SideSell = "SELL"
+)
+
+const (
+	OrderTypeLimit  = "LIMIT"
+	OrderTypeMarket = "MARKET"
+)
+
+const (
+	OrderStatusNew             = "NEW"
+	OrderStatusPartiallyFilled = "PARTIALLY_FILLED"
+	OrderStatusFilled          = "FILLED"
+	OrderStatusCancelled       = "CANCELLED"
+	OrderStatusExpired         = "EXPIRED"
+	OrderStatusRejected        = "REJECTED"
+)
+
+const (
+	// TimeInForceGTC orders rest until they fill or are cancelled; orders
+	// without a time in force are GTC.
+	TimeInForceGTC = "GTC"
+	// TimeInForceDay orders expire at the close of the session they were
+	// placed for.
+	TimeInForceDay = "DAY"
+)
+
+// LotSize is the number of shares in one IDX board lot.
+const LotSize = 100
+
 type Order struct {
+	ID        string    `json:"id"`
+	Owner     string    `json:"owner"`
+	Ticker    string    `json:"ticker"`
+	Side      string    `json:"side"`
+	Type      string    `json:"type"`
+	Price     float64   `json:"price"`
+	Quantity  int       `json:"quantity"`
+	Filled    int       `json:"filled"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Liquidation marks an order placed to close out an account in a margin
+	// call. It is not held to the account's buying power or risk limits.
+	Liquidation bool   `json:"liquidation,omitempty"`
+	TimeInForce string `json:"time_in_force,omitempty"`
+}
+
+func (o Order) Remaining() int {
+	return o.Quantity - o.Filled
+}
+
+func (o Order) IsOpen() bool {
+	return o.Status == OrderStatusNew || o.Status == OrderStatusPartiallyFilled
+}
+
+type PriceLevel struct {
 	Price     float64 `json:"price"`
 	Volume    int     `json:"volume"`
 	Frequency int     `json:"frequency"`
 }
 
 type Trade struct {
-	ID        string    `json:"id"`
-	Ticker    string    `json:"ticker"`
-	Price     float64   `json:"price"`
-	Size      int       `json:"size"`
-	Side      string    `json:"side"`
+	ID          string    `json:"id"`
+	Ticker      string    `json:"ticker"`
+	Price       float64   `json:"price"`
+	Size        int       `json:"size"`
+	Side        string    `json:"side"`
+	Timestamp   time.Time `json:"timestamp"`
+	BuyOrderID  string    `json:"buy_order_id,omitempty"`
+	SellOrderID string    `json:"sell_order_id,omitempty"`
+	Buyer       string    `json:"buyer,omitempty"`
+	Seller      string    `json:"seller,omitempty"`
+	// BuyerFees and SellerFees are charged to sides that are accounts.
+	BuyerFees  *Fees `json:"buyer_fees,omitempty"`
+	SellerFees *Fees `json:"seller_fees,omitempty"`
+}
+
+// Fees are what one side of a trade pays, in whole rupiah.
+type Fees struct {
+	Commission float64 `json:"commission"`
+	// Levy is the exchange transaction levy.
+	Levy     float64 `json:"levy"`
+	Clearing float64 `json:"clearing"`
+	// VAT is charged on the commission.
+	VAT float64 `json:"vat"`
+	// SalesTax is the final income tax on sells.
+	SalesTax float64 `json:"sales_tax,omitempty"`
+}
+
+func (fees Fees) Total() float64 {
+	return fees.Commission + fees.Levy + fees.Clearing + fees.VAT + fees.SalesTax
+}
+
+const (
+	EventOrderAccepted  = "ORDER_ACCEPTED"
+	EventOrderCancelled = "ORDER_CANCELLED"
+	EventOrderExpired   = "ORDER_EXPIRED"
+	EventTrade          = "TRADE"
+	EventPriceUpdate    = "PRICE_UPDATE"
+	// EventCorporateAction marks a corporate action taking effect; the
+	// order and price adjustments it causes follow as their own events.
+	EventCorporateAction  = "CORPORATE_ACTION"
+	EventOrderAdjusted    = "ORDER_ADJUSTED"
+	EventAccountOpened    = "ACCOUNT_OPENED"
+	EventAccountFunded    = "ACCOUNT_FUNDED"
+	EventAccountWithdrawn = "ACCOUNT_WITHDRAWN"
+	// EventSettlement is the end-of-day settlement run, which settles every
+	// fill due on or before its settlement date.
+	EventSettlement = "SETTLEMENT"
+	// EventMarginCall puts a margin account in a margin call, and
+	// EventMarginCallMet ends it once the account is back above maintenance.
+	EventMarginCall    = "MARGIN_CALL"
+	EventMarginCallMet = "MARGIN_CALL_MET"
+)
+
+// Event is one state change in the engine. Sequence numbers are gapless and
+// increase in the order the changes were applied.
+type Event struct {
+	Sequence  uint64    `json:"seq"`
+	Type      string    `json:"type"`
 	Timestamp time.Time `json:"timestamp"`
+	Order     *Order    `json:"order,omitempty"`
+	Trade     *Trade    `json:"trade,omitempty"`
+	Ticker    string    `json:"ticker,omitempty"`
+	Price     float64   `json:"price,omitempty"`
+
+	CorporateAction *CorporateAction `json:"corporate_action,omitempty"`
+	Account         *AccountChange   `json:"account,omitempty"`
+	SettlementDate  time.Time        `json:"settlement_date,omitzero"`
+	MarginCall      *MarginCall      `json:"margin_call,omitempty"`
+}
+
+// AccountChange is the account an ACCOUNT_* event is about.
+type AccountChange struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	// Tier is the fee tier an account is opened on.
+	Tier string `json:"tier,omitempty"`
+	// Amount is the cash paid in or withdrawn.
+	Amount float64 `json:"amount,omitempty"`
+	// Margin opens a margin account.
+	Margin bool `json:"margin,omitempty"`
+	// Owner is the subject of the caller who opened the account.
+	Owner string `json:"owner,omitempty"`
+}
+
+// EngineSnapshot is the engine state after the event with the given
+// sequence number. Orders are listed in book priority.
+type EngineSnapshot struct {
+	Sequence      uint64             `json:"seq"`
+	TakenAt       time.Time          `json:"taken_at"`
+	OrderSequence uint64             `json:"order_seq"`
+	TradeSequence uint64             `json:"trade_seq"`
+	Orders        []Order            `json:"orders"`
+	Trades        []Trade            `json:"trades"`
+	LastPrices    map[string]float64 `json:"last_prices"`
+	Fundamentals  map[string]float64 `json:"fundamentals"`
+	// CorporateActions have taken effect since the reference snapshot.
+	CorporateActions []CorporateAction `json:"corporate_actions,omitempty"`
+	Accounts         []Account         `json:"accounts,omitempty"`
 }
 
 type OrderBook struct {
-	Bids []Order `json:"bids"`
-	Asks []Order `json:"asks"`
+	Ticker string       `json:"ticker"`
+	Bids   []PriceLevel `json:"bids"`
+	Asks   []PriceLevel `json:"asks"`
 }
 
 type Stock struct {
@@ -33,3 +183,391 @@ type Stock struct {
 	Value     string
 	Frequency string
 }
+
+// DailyBar is one symbol's end-of-day summary from a daily snapshot.
+type DailyBar struct {
+	Date      time.Time `json:"date"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	Change    float64   `json:"change"`
+	Volume    int64     `json:"volume"`
+	Value     int64     `json:"value"`
+	Frequency int64     `json:"frequency"`
+}
+
+// ForeignFlow is one symbol's foreign investor activity on a trading day,
+// in shares.
+type ForeignFlow struct {
+	Date       time.Time `json:"date"`
+	Code       string    `json:"code"`
+	BuyVolume  int64     `json:"buy_volume"`
+	SellVolume int64     `json:"sell_volume"`
+}
+
+// NetVolume is net foreign buying; negative is net foreign selling.
+func (f ForeignFlow) NetVolume() int64 {
+	return f.BuyVolume - f.SellVolume
+}
+
+// BrokerSummary is one exchange member's total activity on a trading day.
+type BrokerSummary struct {
+	Date       time.Time `json:"date"`
+	BrokerCode string    `json:"broker_code"`
+	BrokerName string    `json:"broker_name"`
+	Volume     int64     `json:"volume"`
+	Value      int64     `json:"value"`
+	Frequency  int64     `json:"frequency"`
+}
+
+// SectorIndices are the IDX-IC sector indices, by code, and the sectors
+// their constituents are in.
+var SectorIndices = map[string]string{
+	"IDXENERGY":  "Energy",
+	"IDXBASIC":   "Basic Materials",
+	"IDXINDUST":  "Industrials",
+	"IDXNONCYC":  "Consumer Non-Cyclicals",
+	"IDXCYCLIC":  "Consumer Cyclicals",
+	"IDXHEALTH":  "Healthcare",
+	"IDXFINANCE": "Financials",
+	"IDXPROPERT": "Properties & Real Estate",
+	"IDXTECHNO":  "Technology",
+	"IDXINFRA":   "Infrastructures",
+	"IDXTRANS":   "Transportation & Logistic",
+}
+
+// IndexConstituent is a stock in an index such as LQ45 on a given day.
+type IndexConstituent struct {
+	Date  time.Time `json:"date"`
+	Index string    `json:"index"`
+	Code  string    `json:"code"`
+	Name  string    `json:"name"`
+	// Weight is the stock's share of the index in percent; zero when the
+	// source does not publish weights.
+	Weight float64 `json:"weight"`
+}
+
+const (
+	CorporateActionDividend     = "DIVIDEND"
+	CorporateActionSplit        = "SPLIT"
+	CorporateActionReverseSplit = "REVERSE_SPLIT"
+	CorporateActionRightsIssue  = "RIGHTS_ISSUE"
+)
+
+// CorporateAction is a dividend, stock split, reverse split or rights issue.
+// An action is identified by its code, type and ex-date.
+type CorporateAction struct {
+	Code string `json:"code"`
+	Type string `json:"type"`
+	// ExDate is the first day the stock trades without the entitlement.
+	ExDate      time.Time `json:"ex_date"`
+	RecordDate  time.Time `json:"record_date"`
+	PaymentDate time.Time `json:"payment_date"`
+	// Amount is the cash dividend per share.
+	Amount float64 `json:"amount,omitempty"`
+	// Ratio is new shares per old share: 5 for a 1:5 split, 0.2 for a 5:1
+	// reverse split and 0.25 for rights of one new share per four held.
+	Ratio float64 `json:"ratio,omitempty"`
+	// Price is the rights issue exercise price.
+	Price       float64 `json:"price,omitempty"`
+	Description string  `json:"description,omitempty"`
+}
+
+// PriceFactor is what prices before the ex-date are multiplied by to be
+// comparable with prices from the ex-date on, given the last close before
+// it: the inverse ratio for splits, the close less the dividend as a share
+// of the close for dividends, and the theoretical ex-rights price as a
+// share of the close for rights issues.
+func (action CorporateAction) PriceFactor(previousClose float64) float64 {
+	switch action.Type {
+	case CorporateActionSplit, CorporateActionReverseSplit:
+		if action.Ratio > 0 {
+			return 1 / action.Ratio
+		}
+	case CorporateActionDividend:
+		if action.Amount > 0 && previousClose > action.Amount {
+			return (previousClose - action.Amount) / previousClose
+		}
+	case CorporateActionRightsIssue:
+		if action.Ratio > 0 && action.Price < previousClose {
+			exRights := (previousClose + action.Ratio*action.Price) / (1 + action.Ratio)
+			return exRights / previousClose
+		}
+	}
+
+	return 1
+}
+
+// VolumeFactor is what share counts before the ex-date are multiplied by.
+// Only splits change the share units.
+func (action CorporateAction) VolumeFactor() float64 {
+	switch action.Type {
+	case CorporateActionSplit, CorporateActionReverseSplit:
+		if action.Ratio > 0 {
+			return action.Ratio
+		}
+	}
+
+	return 1
+}
+
+// Account is a paper-trading account. Amounts are in rupiah. Cash and
+// positions are trade-date balances: fills count from the day they trade,
+// and Unsettled lists those not yet settled. A margin account borrows when
+// its cash goes negative and sells short when its positions do.
+type Account struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Tier   string `json:"tier"`
+	Margin bool   `json:"margin,omitempty"`
+	// Owner is the subject of the caller who opened the account; empty when
+	// the engine ran without authentication.
+	Owner     string    `json:"owner,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Cash      float64   `json:"cash"`
+	// Reserved is cash held for open buy orders, fees included. On a margin
+	// account it is the margin held for open orders that add to positions.
+	Reserved float64 `json:"reserved"`
+	// FeesPaid is every fee and tax charged on fills.
+	FeesPaid float64 `json:"fees_paid"`
+	// DayRealizedPnL is the P&L realized on the fills of TradingDay.
+	DayRealizedPnL float64      `json:"day_realized_pnl"`
+	TradingDay     time.Time    `json:"trading_day,omitzero"`
+	Positions      []Position   `json:"positions,omitempty"`
+	Unsettled      []Settlement `json:"unsettled,omitempty"`
+	// MarginCall is set while a margin account is in a margin call.
+	MarginCall *MarginCall `json:"margin_call,omitempty"`
+}
+
+// BuyingPower is the cash not held for open orders, less unsettled sale
+// proceeds unless they may be spent before they settle.
+func (account Account) BuyingPower(spendUnsettled bool) float64 {
+	if spendUnsettled {
+		return account.Cash - account.Reserved
+	}
+
+	return account.Cash - account.Reserved - account.UnsettledProceeds()
+}
+
+// RealizedOn is the P&L realized on a trading day's fills.
+func (account Account) RealizedOn(day time.Time) float64 {
+	if !account.TradingDay.Equal(day) {
+		return 0
+	}
+
+	return account.DayRealizedPnL
+}
+
+// Withdrawable is the settled cash not held for open orders.
+func (account Account) Withdrawable() float64 {
+	return max(account.Cash-account.Reserved-account.UnsettledProceeds(), 0)
+}
+
+// SettledCash is the settlement-date cash balance.
+func (account Account) SettledCash() float64 {
+	settled := account.Cash
+	for _, settlement := range account.Unsettled {
+		settled -= settlement.Amount
+	}
+
+	return settled
+}
+
+// UnsettledProceeds is the cash from sells that have not settled.
+func (account Account) UnsettledProceeds() float64 {
+	proceeds := 0.0
+	for _, settlement := range account.Unsettled {
+		proceeds += max(settlement.Amount, 0)
+	}
+
+	return proceeds
+}
+
+// SettledQuantity is the settlement-date share balance of a symbol.
+func (account Account) SettledQuantity(symbol string) int {
+	settled := 0
+	for _, position := range account.Positions {
+		if position.Symbol == symbol {
+			settled = position.Quantity
+		}
+	}
+	for _, settlement := range account.Unsettled {
+		if settlement.Symbol == symbol {
+			settled -= settlement.Quantity
+		}
+	}
+
+	return settled
+}
+
+// Settlement is one fill's cash and shares awaiting settlement. Amount and
+// Quantity are what the fill adds to the account: positive for a sell's
+// proceeds, net of fees, and a buy's shares, negative for a buy's payment
+// and a sell's shares.
+type Settlement struct {
+	TradeID        string    `json:"trade_id"`
+	Symbol         string    `json:"symbol"`
+	Side           string    `json:"side"`
+	Quantity       int       `json:"quantity"`
+	Amount         float64   `json:"amount"`
+	TradeDate      time.Time `json:"trade_date"`
+	SettlementDate time.Time `json:"settlement_date"`
+}
+
+// Position is an account's holding in one symbol. A position sold down to
+// zero is kept for its realized P&L. Quantity is negative for a short
+// position, whose shares are borrowed.
+type Position struct {
+	Symbol   string `json:"symbol"`
+	Quantity int    `json:"quantity"`
+	// Reserved is shares held for open sell orders.
+	Reserved int `json:"reserved"`
+	// AverageCost includes buy fees, and RealizedPnL is net of sell fees.
+	// For a short position AverageCost is the average sale price net of
+	// fees.
+	AverageCost float64 `json:"average_cost"`
+	RealizedPnL float64 `json:"realized_pnl"`
+}
+
+// Available is the shares held, long, that are not held for open sell
+// orders.
+func (position Position) Available() int {
+	return max(position.Quantity-position.Reserved, 0)
+}
+
+// MarginCall is a demand for a margin account to bring its equity back up
+// to the maintenance requirement by Deadline, on the engine clock, before
+// its positions are liquidated.
+type MarginCall struct {
+	IssuedAt time.Time `json:"issued_at"`
+	Deadline time.Time `json:"deadline"`
+	// Equity and Requirement are the account's equity and maintenance
+	// requirement when the call was issued.
+	Equity      float64 `json:"equity"`
+	Requirement float64 `json:"requirement"`
+}
+
+// MarginStatus is a margin account valued at last prices. Requirements are
+// the equity the positions need at the initial, maintenance and
+// liquidation ratios.
+type MarginStatus struct {
+	Equity     float64 `json:"equity"`
+	LongValue  float64 `json:"long_value"`
+	ShortValue float64 `json:"short_value"`
+
+	InitialRequirement     float64 `json:"initial_requirement"`
+	MaintenanceRequirement float64 `json:"maintenance_requirement"`
+	LiquidationRequirement float64 `json:"liquidation_requirement"`
+	// Excess is the equity above the initial requirement that open orders
+	// do not hold, which new positions can use.
+	Excess float64 `json:"excess"`
+}
+
+const (
+	AccountUpdateOpened          = "OPENED"
+	AccountUpdateFunded          = "FUNDED"
+	AccountUpdateOrder           = "ORDER"
+	AccountUpdateFill            = "FILL"
+	AccountUpdateCorporateAction = "CORPORATE_ACTION"
+	AccountUpdateWithdrawn       = "WITHDRAWN"
+	AccountUpdateSettled         = "SETTLED"
+	AccountUpdateMarginCall      = "MARGIN_CALL"
+	AccountUpdateMarginCallMet   = "MARGIN_CALL_MET"
+)
+
+// Portfolio is an account valued at last prices.
+type Portfolio struct {
+	AccountID     string    `json:"account_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Cash          float64   `json:"cash"`
+	MarketValue   float64   `json:"market_value"`
+	Equity        float64   `json:"equity"`
+	RealizedPnL   float64   `json:"realized_pnl"`
+	UnrealizedPnL float64   `json:"unrealized_pnl"`
+	// Positions are the open positions, by symbol.
+	Positions []PositionValue `json:"positions"`
+	// Sectors are the open positions' exposure by sector, largest first.
+	Sectors []SectorExposure `json:"sectors"`
+}
+
+type PositionValue struct {
+	Position
+	SettledQuantity int     `json:"settled_quantity"`
+	Sector          string  `json:"sector"`
+	LastPrice       float64 `json:"last_price"`
+	MarketValue     float64 `json:"market_value"`
+	UnrealizedPnL   float64 `json:"unrealized_pnl"`
+}
+
+type SectorExposure struct {
+	Sector        string  `json:"sector"`
+	MarketValue   float64 `json:"market_value"`
+	UnrealizedPnL float64 `json:"unrealized_pnl"`
+	// Weight is the market value's share of equity.
+	Weight float64 `json:"weight"`
+}
+
+// AccountUpdate is a change to an account and the account after it.
+type AccountUpdate struct {
+	Reason    string    `json:"reason"`
+	Timestamp time.Time `json:"timestamp"`
+	Account   Account   `json:"account"`
+	// Symbols are the positions that changed.
+	Symbols []string `json:"symbols,omitempty"`
+	// Trade is the fill behind a FILL update, and Fees what the account
+	// paid on it.
+	Trade *Trade `json:"trade,omitempty"`
+	Fees  *Fees  `json:"fees,omitempty"`
+}
+
+const (
+	ExecutionNew         = "NEW"
+	ExecutionPartialFill = "PARTIAL_FILL"
+	ExecutionFill        = "FILL"
+	ExecutionCancelled   = "CANCELLED"
+	ExecutionReplaced    = "REPLACED"
+	ExecutionRejected    = "REJECTED"
+	ExecutionExpired     = "EXPIRED"
+)
+
+// ExecutionReport is one step in the life of an account's order. Sequence
+// numbers are gapless per account, so a client that reconnects can resume
+// after the last report it saw.
+type ExecutionReport struct {
+	Account   string    `json:"account"`
+	Sequence  uint64    `json:"seq"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	// Order is the order as this report leaves it.
+	Order Order `json:"order"`
+	// LastQuantity, LastPrice, TradeID and Fees describe the fill of a
+	// PARTIAL_FILL or FILL report.
+	LastQuantity int     `json:"last_quantity,omitempty"`
+	LastPrice    float64 `json:"last_price,omitempty"`
+	TradeID      string  `json:"trade_id,omitempty"`
+	Fees         *Fees   `json:"fees,omitempty"`
+	// CumulativeQuantity is the quantity filled so far, at AveragePrice,
+	// and LeavesQuantity what is still working.
+	CumulativeQuantity int     `json:"cumulative_quantity"`
+	LeavesQuantity     int     `json:"leaves_quantity"`
+	AveragePrice       float64 `json:"average_price"`
+	// RejectReason is an OrderRejectReason name and Text explains a
+	// rejection.
+	RejectReason string `json:"reject_reason,omitempty"`
+	Text         string `json:"text,omitempty"`
+}
+
+// AccountTrade is an account's side of a fill, for its trade history.
+type AccountTrade struct {
+	Account   string    `json:"account"`
+	TradeID   string    `json:"trade_id"`
+	OrderID   string    `json:"order_id"`
+	Symbol    string    `json:"symbol"`
+	Side      string    `json:"side"`
+	Price     float64   `json:"price"`
+	Quantity  int       `json:"quantity"`
+	Fees      *Fees     `json:"fees,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -1,21 +1,122 @@
 package utils
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
 
-func ParseStockFloat(s string) (float64, error) {
-	dotIndex := strings.LastIndex(s, ".")
+// NumberFormat says how numbers in scraped or exported data are written.
+type NumberFormat string
 
-	if dotIndex != -1 {
-		fractionalPart := s[dotIndex+1:]
+const (
+	// NumberFormatIDX is the Indonesian locale used by idx.co.id: dots group
+	// thousands and a comma marks decimals, so "7.475" is 7475 and
+	// "1.234,5" is 1234.5.
+	NumberFormatIDX NumberFormat = "idx"
+	// NumberFormatPlain is Go's own syntax: "7475" or "7.475" is 7.475.
+	NumberFormatPlain NumberFormat = "plain"
+)
+
+func ParseNumberFormat(s string) (NumberFormat, error) {
+	switch format := NumberFormat(strings.ToLower(s)); format {
+	case NumberFormatIDX, NumberFormatPlain:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown number format %q", s)
+	}
+}
+
+// ParseNumber parses s in the given format. IDX numbers must group digits
+// in threes after the first group, so a misread plain decimal is rejected
+// rather than turned into a wrong value.
+func ParseNumber(s string, format NumberFormat) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("empty number")
+	}
+
+	switch format {
+	case NumberFormatPlain:
+		return strconv.ParseFloat(s, 64)
+	case NumberFormatIDX:
+		return parseIDXNumber(s)
+	default:
+		return 0, fmt.Errorf("unknown number format %q", format)
+	}
+}
+
+func parseIDXNumber(s string) (float64, error) {
+	sign := ""
+	digits := s
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		sign, digits = digits[:1], digits[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(digits, ",")
+
+	groups := strings.Split(integer, ".")
+	for i, group := range groups {
+		if !isDigits(group) || (i > 0 && len(group) != 3) || (i == 0 && len(groups) > 1 && len(group) > 3) {
+			return 0, fmt.Errorf("invalid IDX number %q", s)
+		}
+	}
+
+	clean := sign + strings.Join(groups, "")
+	if hasFraction {
+		if !isDigits(fraction) {
+			return 0, fmt.Errorf("invalid IDX number %q", s)
+		}
+		clean += "." + fraction
+	}
 
-		if len(fractionalPart) == 3 {
-			cleanString := strings.ReplaceAll(s, ".", "")
-			return strconv.ParseFloat(cleanString, 64)
+	value, err := strconv.ParseFloat(clean, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid IDX number %q", s)
+	}
+
+	return value, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FormatNumber writes value in the given format, the inverse of ParseNumber.
+func FormatNumber(value float64, format NumberFormat) string {
+	plain := strconv.FormatFloat(value, 'f', -1, 64)
+	if format != NumberFormatIDX {
+		return plain
+	}
+
+	sign := ""
+	if strings.HasPrefix(plain, "-") {
+		sign, plain = "-", plain[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(plain, ".")
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte('.')
 		}
+		grouped.WriteRune(digit)
+	}
+
+	if hasFraction {
+		return sign + grouped.String() + "," + fraction
 	}
 
-	return strconv.ParseFloat(s, 64)
+	return sign + grouped.String()
 }